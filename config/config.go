@@ -14,6 +14,9 @@ import (
 
 type Config struct {
 	Servers []ServerConfig
+
+	// Glob pattern for included config files (from include_dir), if any
+	IncludePattern string
 }
 
 // ServerIdentifier -
@@ -36,6 +39,9 @@ type ServerIdentifier struct {
 //	Contains the information how to connect to a Postgres instance,
 //	with optional AWS credentials to get metrics
 //	from AWS CloudWatch as well as RDS logfiles
+//
+//	Settings in config files may reference environment variables and files, e.g.
+//	"${env:PGPASSWORD}" or "${file:/run/secrets/api_key}" (use "$${" for a literal "${")
 type ServerConfig struct {
 	APIKey     string `ini:"api_key"`
 	APIBaseURL string `ini:"api_base_url"`
@@ -47,6 +53,10 @@ type ServerConfig struct {
 	DisableActivity  bool `ini:"disable_activity"`
 	EnableLogExplain bool `ini:"enable_log_explain"`
 
+	// Derive per-query statistics from log_statement=all/log_duration=on output
+	// (instead of discarding those lines), for servers without pg_stat_statements
+	EnableLogQueryStats bool `ini:"enable_log_query_stats"`
+
 	DbURL                 string `ini:"db_url"`
 	DbURLFile             string `ini:"db_url_file"`
 	DbName                string `ini:"db_name"`
@@ -64,6 +74,34 @@ type ServerConfig struct {
 	DbSslKeyContents      string `ini:"db_sslkey_contents"`
 	DbUseIamAuth          bool   `ini:"db_use_iam_auth"`
 
+	// Retrieve the database username and password from a secret provider for each
+	// new connection, instead of using db_username/db_password: "vault_kv" (Vault KV
+	// secrets engine, version 2), "vault_database" (dynamic credentials from the Vault
+	// database secrets engine) or "aws_secrets_manager". Secrets read from Vault KV
+	// or AWS Secrets Manager must contain "username" and "password" keys.
+	DbSecretProvider string `ini:"db_secret_provider"`
+
+	// How long credentials from Vault KV or AWS Secrets Manager are used before being
+	// read again (e.g. "5m"), so rotated credentials get picked up. Credentials are
+	// also read again when authentication fails. Defaults to 5 minutes.
+	DbSecretRefreshInterval       string `ini:"db_secret_refresh_interval"`
+	DbSecretRefreshIntervalParsed time.Duration
+
+	// HashiCorp Vault settings, used with the "vault_kv" and "vault_database" secret
+	// providers. The KV secret path (e.g. "pganalyze/db") is relative to the KV mount,
+	// and the database role is the role in the database secrets engine mount.
+	VaultAddr          string `ini:"vault_addr"`
+	VaultToken         string `ini:"vault_token"`
+	VaultNamespace     string `ini:"vault_namespace"`
+	VaultKvMount       string `ini:"vault_kv_mount"`
+	VaultSecretPath    string `ini:"vault_secret_path"`
+	VaultDatabaseMount string `ini:"vault_database_mount"`
+	VaultDatabaseRole  string `ini:"vault_database_role"`
+
+	// AWS Secrets Manager secret name or ARN, used with the "aws_secrets_manager"
+	// secret provider
+	AwsSecretsManagerSecretID string `ini:"aws_secrets_manager_secret_id"`
+
 	// Postgres data directory, as used for system stats (autodetected if unset)
 	DbDataDirectory string `ini:"db_data_directory"`
 
@@ -94,6 +132,7 @@ type ServerConfig struct {
 	AwsEndpointEc2URL              string `ini:"aws_endpoint_ec2_url"`
 	AwsEndpointCloudwatchURL       string `ini:"aws_endpoint_cloudwatch_url"`
 	AwsEndpointCloudwatchLogsURL   string `ini:"aws_endpoint_cloudwatch_logs_url"`
+	AwsEndpointSecretsManagerURL   string `ini:"aws_endpoint_secrets_manager_url"`
 
 	AzureDbServerName          string `ini:"azure_db_server_name"`
 	AzureEventhubNamespace     string `ini:"azure_eventhub_namespace"`
@@ -122,6 +161,8 @@ type ServerConfig struct {
 
 	AivenProjectID string `ini:"aiven_project_id"`
 	AivenServiceID string `ini:"aiven_service_id"`
+	AivenAPIToken  string `ini:"aiven_api_token"`
+	AivenAPIURL    string `ini:"aiven_api_url"` // default: https://api.aiven.io
 
 	TemboNamespace     string `ini:"tembo_namespace"`
 	TemboOrgID         string `ini:"tembo_org_id"`
@@ -139,8 +180,12 @@ type ServerConfig struct {
 	PlanetScaleLogsURL     string `ini:"planetscale_logs_url"` // default: https://logs.psdb.cloud
 
 	SectionName string
+	SectionFile string // Config file the section was read from
 	Identifier  ServerIdentifier
 
+	// Files referenced with ${file:...} in settings
+	ReferencedFiles []string
+
 	SystemID            string `ini:"api_system_id"`
 	SystemType          string `ini:"api_system_type"`
 	SystemScope         string `ini:"api_system_scope"`
@@ -184,6 +229,25 @@ type ServerConfig struct {
 	LogOtelK8SPodName        string
 	LogOtelK8SLabelSelectors []string
 
+	// Configures the collector to consume Postgres log messages from a Kafka
+	// topic, using the specified comma-separated list of "hostname:port" brokers.
+	// Each message is expected to contain one log event in the specified format
+	// ("stderr", "csvlog" or "jsonlog"). Messages are consumed as part of a
+	// consumer group (defaults to "pganalyze-collector"), so each server needs
+	// its own topic, or its own consumer group.
+	LogKafkaBrokers       string `ini:"db_log_kafka_brokers"`
+	LogKafkaTopic         string `ini:"db_log_kafka_topic"`
+	LogKafkaConsumerGroup string `ini:"db_log_kafka_consumer_group"`
+	LogKafkaFormat        string `ini:"db_log_kafka_format"`
+	// For SASL authentication (mechanism: "plain", "scram-sha-256" or
+	// "scram-sha-512") and TLS support for Kafka
+	LogKafkaSaslMechanism string `ini:"db_log_kafka_sasl_mechanism"`
+	LogKafkaSaslUsername  string `ini:"db_log_kafka_sasl_username"`
+	LogKafkaSaslPassword  string `ini:"db_log_kafka_sasl_password"`
+	LogKafkaTLS           bool   `ini:"db_log_kafka_tls"`
+	LogKafkaTLSCAFile     string `ini:"db_log_kafka_tls_ca_file"`
+	LogKafkaTLSCAContents string `ini:"db_log_kafka_tls_ca_contents"`
+
 	// Overrides how many seconds the "--test-logs" log test waits for the emitted
 	// test event to arrive before giving up. Defaults to 10s, or 30s for platforms
 	// whose logs arrive via a batched push drain (e.g. Supabase). Raise it if the
@@ -197,10 +261,17 @@ type ServerConfig struct {
 	// function. Used by default for Crunchy Bridge.
 	LogPgReadFile bool `ini:"db_log_pg_read_file"`
 
+	// Configures the collector to read Amazon RDS/Aurora logs from the log group
+	// exported to CloudWatch Logs, instead of downloading log files through the
+	// RDS API (which is heavily rate-limited, and can truncate lines on busy
+	// servers). Requires the "postgresql" log export to be enabled, as well as the
+	// logs:DescribeLogStreams and logs:GetLogEvents permissions.
+	LogAwsCloudwatchLogs bool `ini:"db_log_aws_cloudwatch_logs"`
+
 	// Specifies how often logs are downloaded, in seconds, for servers where the
-	// collector polls for logs (Amazon RDS/Aurora, db_log_pg_read_file, and
-	// PlanetScale). This has no effect for log streaming setups (e.g. local log
-	// tailing, Azure Event Hub, or Google Cloud Pub/Sub).
+	// collector polls for logs (Amazon RDS/Aurora, db_log_pg_read_file,
+	// PlanetScale, and Aiven). This has no effect for log streaming setups (e.g.
+	// local log tailing, Azure Event Hub, or Google Cloud Pub/Sub).
 	//
 	// Raising this reduces the number of API/database calls made to fetch logs, at
 	// the cost of log data arriving in pganalyze less promptly, and in larger
@@ -240,6 +311,17 @@ type ServerConfig struct {
 	// the collector multiple times against the same database server
 	MaxCollectorConnections int `ini:"max_collector_connections"`
 
+	// Number of databases whose schema information is collected in parallel, when
+	// monitoring multiple databases. This is further limited to stay within
+	// max_collector_connections. Defaults to 4.
+	SchemaCollectionConcurrency int `ini:"schema_collection_concurrency"`
+
+	// How long idle connections for activity snapshots, high frequency statistics,
+	// query runs and log-based EXPLAIN are kept open for reuse (e.g. "2m"). Set to
+	// "0" to close connections after each use instead. Defaults to 2 minutes.
+	DbConnectionIdleTimeout       string `ini:"db_connection_idle_timeout"`
+	DbConnectionIdleTimeoutParsed time.Duration
+
 	// Do not monitor this server while it is a replica (according to pg_is_in_recovery),
 	// but keep checking on standard snapshot intervals and automatically start monitoring
 	// once the server is promoted
@@ -250,10 +332,16 @@ type ServerConfig struct {
 	MaxBufferCacheMonitoringGB int `ini:"max_buffer_cache_monitoring_gb"`
 
 	// Configuration for PII filtering
-	FilterLogSecret   string `ini:"filter_log_secret"`   // none/all/credential/parsing_error/statement_text/statement_parameter/table_data/ops/unidentified (comma separated)
+	FilterLogSecret   string `ini:"filter_log_secret"`   // none/all, or a comma-separated list of FilterLogSecretKinds
 	FilterQuerySample string `ini:"filter_query_sample"` // none/normalize/all (defaults to "none")
 	FilterQueryText   string `ini:"filter_query_text"`   // none/unparsable (defaults to "unparsable")
 
+	// Additional rules for application-specific data (e.g. emails or tenant tokens)
+	// that is always redacted from log lines and query sample parameters, with one
+	// "name: regexp" rule per line (use """ to specify multiple lines)
+	FilterLogRules       string `ini:"filter_log_rules"`
+	FilterLogRulesParsed []LogRedactionRule
+
 	// Configuration for OpenTelemetry trace exports
 	//
 	// See https://github.com/open-telemetry/opentelemetry-specification/blob/v1.20.0/specification/protocol/exporter.md
@@ -273,6 +361,60 @@ type ServerConfig struct {
 	// Require WebSocket connection (if false, will try WebSocket but fall back to legacy HTTPS API)
 	APIRequireWebsocket bool `ini:"api_require_websocket"`
 
+	// Directory where snapshots are kept when they can't be uploaded (e.g. due to
+	// network issues, or pganalyze API maintenance), so they can be sent once the
+	// API is reachable again. Each server uses its own subdirectory. Disabled if
+	// not set.
+	SnapshotSpoolDir string `ini:"snapshot_spool_dir"`
+
+	// Maximum size of the snapshot spool for each server in megabytes - the oldest
+	// snapshots are discarded first once this is reached. Defaults to 100 MB.
+	SnapshotSpoolMaxSizeMB int `ini:"snapshot_spool_max_size_mb"`
+
+	// Maximum age of spooled snapshots (e.g. "6h"), older snapshots are discarded
+	// instead of being uploaded. Defaults to 24 hours.
+	SnapshotSpoolMaxAge       string `ini:"snapshot_spool_max_age"`
+	SnapshotSpoolMaxAgeParsed time.Duration
+
+	// Directory where analyzed log lines are archived locally as newline-delimited
+	// JSON (after secrets were filtered), e.g. for ingestion into a SIEM. Each server
+	// uses its own subdirectory. Disabled if not set.
+	LogArchiveDir string `ini:"log_archive_dir"`
+
+	// Maximum size of a log archive file in megabytes, before a new file is
+	// started. Defaults to 100 MB.
+	LogArchiveMaxFileSizeMB int `ini:"log_archive_max_file_size_mb"`
+
+	// Maximum time a log archive file is written to (e.g. "1h"), before a new file
+	// is started. Defaults to 24 hours.
+	LogArchiveRotateInterval       string `ini:"log_archive_rotate_interval"`
+	LogArchiveRotateIntervalParsed time.Duration
+
+	// Maximum number of log archive files kept for each server - the oldest files
+	// are removed once this is reached. Defaults to 0 (all files are kept).
+	LogArchiveMaxFiles int `ini:"log_archive_max_files"`
+
+	// Address (e.g. "localhost:9187") to serve collector and Postgres metrics on,
+	// in OpenMetrics format at /metrics. Servers that share the same address are
+	// distinguished by a "server" label. Disabled if not set.
+	MetricsListenAddress string `ini:"metrics_listen_address"`
+
+	// Address (e.g. "0.0.0.0:8080") to serve the /healthz and /readyz endpoints
+	// on, for use with liveness and readiness probes. May be the same address as
+	// metrics_listen_address. Disabled if not set.
+	HealthListenAddress string `ini:"health_listen_address"`
+
+	// Maximum time since the last successful full snapshot (e.g. "30m") before
+	// the collector is reported as not ready. Defaults to 30 minutes.
+	HealthFullSnapshotMaxAge       string `ini:"health_full_snapshot_max_age"`
+	HealthFullSnapshotMaxAgeParsed time.Duration
+
+	// Maximum time since log data was last received (e.g. "15m") before the
+	// collector is reported as not ready. Disabled if not set, since servers
+	// with little activity may legitimately not produce any log output.
+	HealthLogMaxAge       string `ini:"health_log_max_age"`
+	HealthLogMaxAgeParsed time.Duration
+
 	// WebSocket URL to be used for API WebSocket connection
 	WebSocketUrl string
 
@@ -281,6 +423,19 @@ type ServerConfig struct {
 	OTelTracingProviderShutdownFunc func(context.Context) error
 }
 
+// FilterLogSecretKinds - Names of the secret kinds that can be filtered using
+// filter_log_secret, in the same order as state.AllLogSecretKinds (this lives
+// here so config validation can use it, since state depends on config)
+var FilterLogSecretKinds = []string{"credential", "parsing_error", "statement_text", "statement_parameter", "table_data", "ops", "unidentified"}
+
+const DefaultSnapshotSpoolMaxSizeMB = 100
+const DefaultSnapshotSpoolMaxAge = 24 * time.Hour
+const DefaultLogArchiveMaxFileSizeMB = 100
+const DefaultLogArchiveRotateInterval = 24 * time.Hour
+const DefaultHealthFullSnapshotMaxAge = 30 * time.Minute
+const DefaultDbConnectionIdleTimeout = 2 * time.Minute
+const DefaultDbSecretRefreshInterval = 5 * time.Minute
+
 const MinLogDownloadInterval = 30
 const MaxLogDownloadInterval = 600
 
@@ -311,7 +466,12 @@ func (config ServerConfig) MaxLogParsingSize() int {
 
 // SupportsLogDownload - Determines whether the specified config can download logs
 func (config ServerConfig) SupportsLogDownload() bool {
-	return config.AwsDbInstanceID != "" || config.AwsDbClusterID != "" || config.LogPgReadFile || config.SupportsPlanetScaleLogs()
+	return config.AwsDbInstanceID != "" || config.AwsDbClusterID != "" || config.LogPgReadFile || config.SupportsPlanetScaleLogs() || config.SupportsAivenLogs()
+}
+
+// SupportsAivenLogs - Determines whether Aiven logs are configured
+func (config ServerConfig) SupportsAivenLogs() bool {
+	return config.AivenProjectID != "" && config.AivenServiceID != "" && config.AivenAPIToken != ""
 }
 
 // SupportsPlanetScaleLogs - Determines whether PlanetScale logs are configured
@@ -485,6 +645,16 @@ func (config ServerConfig) GetDbURLRedacted() string {
 	}
 
 	u.User = url.User(u.User.Username())
+
+	// Passwords can also be passed as connection parameters
+	query := u.Query()
+	for _, param := range []string{"password", "sslpassword"} {
+		if query.Has(param) {
+			query.Del(param)
+			u.RawQuery = query.Encode()
+		}
+	}
+
 	return u.String()
 }
 
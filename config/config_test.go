@@ -19,6 +19,8 @@ func TestGetDbURLRedacted(t *testing.T) {
 		{"postgres://user@example.com", "postgres://user@example.com"},
 		{string([]byte{0x7f}), "<unparsable>"},
 		{"postgres://user:pass:word@example.com", "postgres://user@example.com"},
+		{"postgres://user@example.com?password=secret&sslmode=require", "postgres://user@example.com?sslmode=require"},
+		{"postgres://user@example.com?sslpassword=secret", "postgres://user@example.com"},
 		{"", ""},
 	}
 	var config config.ServerConfig
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// ServerConfigChange - Settings that changed for a server section present in
+// both configurations
+type ServerConfigChange struct {
+	SectionName string
+	Settings    []string
+}
+
+// ConfigDiff - Server sections that were added, removed or changed between two
+// configurations (only setting names are tracked, since values may be secrets)
+type ConfigDiff struct {
+	Added   []string
+	Removed []string
+	Changed []ServerConfigChange
+}
+
+// Empty - Whether the configurations are effectively the same
+func (d ConfigDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff - Compares the server sections of two configurations by section name
+func Diff(prev Config, next Config) ConfigDiff {
+	var diff ConfigDiff
+
+	prevServers := make(map[string]ServerConfig)
+	for _, server := range prev.Servers {
+		prevServers[server.SectionName] = server
+	}
+	nextServers := make(map[string]bool)
+	for _, server := range next.Servers {
+		nextServers[server.SectionName] = true
+		prevServer, ok := prevServers[server.SectionName]
+		if !ok {
+			diff.Added = append(diff.Added, server.SectionName)
+			continue
+		}
+		settings := changedSettings(prevServer, server)
+		if len(settings) > 0 {
+			diff.Changed = append(diff.Changed, ServerConfigChange{SectionName: server.SectionName, Settings: settings})
+		}
+	}
+	for _, server := range prev.Servers {
+		if !nextServers[server.SectionName] {
+			diff.Removed = append(diff.Removed, server.SectionName)
+		}
+	}
+
+	return diff
+}
+
+// Returns the names of all settings that differ between the two server configs
+//
+// Settings whose values are only derived from other settings (e.g. temporary
+// files written for db_sslcert_contents) are not compared themselves, since they
+// differ each time the config is read.
+func changedSettings(prev ServerConfig, next ServerConfig) []string {
+	var settings []string
+	prevValue := reflect.ValueOf(prev)
+	nextValue := reflect.ValueOf(next)
+	t := prevValue.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("ini")
+		if key == "" || key == "-" {
+			continue
+		}
+		a := prevValue.Field(i).Interface()
+		b := nextValue.Field(i).Interface()
+		if aStr, ok := a.(string); ok && isConfigTempFile(aStr) && isConfigTempFile(b.(string)) {
+			continue
+		}
+		if !reflect.DeepEqual(a, b) {
+			settings = append(settings, key)
+		}
+	}
+	return settings
+}
+
+func isConfigTempFile(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), util.TempFilePrefix)
+}
+
+// RemoveTempFiles - Removes temporary files written when reading the config
+// (e.g. for db_sslcert_contents), for a config that is discarded without being used
+func (conf Config) RemoveTempFiles() {
+	for _, server := range conf.Servers {
+		value := reflect.ValueOf(server)
+		for i := 0; i < value.NumField(); i++ {
+			if value.Field(i).Kind() != reflect.String {
+				continue
+			}
+			path := value.Field(i).String()
+			if isConfigTempFile(path) && filepath.Dir(path) == filepath.Clean(os.TempDir()) {
+				os.Remove(path)
+			}
+		}
+	}
+}
+
+// WatchedFiles - Files the configuration was read from or that it references
+// (e.g. db_password_file), whose changes require reading the configuration again
+func (conf Config) WatchedFiles(filename string) []string {
+	seen := make(map[string]bool)
+	var files []string
+	add := func(file string) {
+		if file == "" || seen[file] {
+			return
+		}
+		seen[file] = true
+		files = append(files, file)
+	}
+	add(filename)
+	for _, server := range conf.Servers {
+		add(server.SectionFile)
+		add(server.DbURLFile)
+		add(server.DbPasswordFile)
+		for _, file := range server.ReferencedFiles {
+			add(file)
+		}
+	}
+	for _, file := range conf.CredentialFiles() {
+		add(file)
+	}
+	return files
+}
+
+// CredentialFiles - Certificate and credential files referenced by the
+// configuration (e.g. db_sslcert), which are only read when connecting, so
+// their contents are not part of the configuration itself
+//
+// Temporary files written for *_contents settings are excluded, as are
+// certificates shipped with the collector, and the AWS web identity token file
+// (which is rotated frequently, and read again by the AWS SDK as needed).
+func (conf Config) CredentialFiles() []string {
+	var files []string
+	for _, server := range conf.Servers {
+		for _, file := range []string{
+			server.DbSslRootCert,
+			server.DbSslCert,
+			server.DbSslKey,
+			server.GcpCredentialsFile,
+			server.LogSyslogServerCAFile,
+			server.LogSyslogServerCertFile,
+			server.LogSyslogServerKeyFile,
+			server.LogSyslogServerClientCAFile,
+			server.LogKafkaTLSCAFile,
+		} {
+			if file == "" || isConfigTempFile(file) || file == "rds-ca-2019-root" || file == "rds-ca-global" {
+				continue
+			}
+			files = append(files, file)
+		}
+	}
+	return files
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/pganalyze/collector/util"
+)
+
+func TestDiff(t *testing.T) {
+	prev := Config{Servers: []ServerConfig{
+		{SectionName: "unchanged", DbHost: "db1.example.com", DbName: "app"},
+		{SectionName: "changed", DbHost: "db2.example.com", DbName: "app", DbPassword: "old"},
+		{SectionName: "removed", DbHost: "db3.example.com", DbName: "app"},
+	}}
+	next := Config{Servers: []ServerConfig{
+		{SectionName: "unchanged", DbHost: "db1.example.com", DbName: "app"},
+		{SectionName: "changed", DbHost: "db2.example.com", DbName: "app", DbPassword: "new", DbExtraNames: []string{"other"}},
+		{SectionName: "added", DbHost: "db4.example.com", DbName: "app"},
+	}}
+
+	diff := Diff(prev, next)
+	expected := ConfigDiff{
+		Added:   []string{"added"},
+		Removed: []string{"removed"},
+		Changed: []ServerConfigChange{{SectionName: "changed", Settings: []string{"db_password"}}},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("want %+v; got %+v", expected, diff)
+	}
+	if diff.Empty() {
+		t.Errorf("want non-empty diff")
+	}
+	if !Diff(prev, prev).Empty() {
+		t.Errorf("want empty diff for identical configs; got %+v", Diff(prev, prev))
+	}
+}
+
+func TestDiffIgnoresTempFiles(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "pganalyze-collector.conf")
+	err := os.WriteFile(filename, []byte("[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_sslrootcert_contents = cert\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each read writes the certificate to a new temporary file
+	prev, err := Read(false, logger, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := Read(false, logger, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Remove(prev.Servers[0].DbSslRootCert)
+		os.Remove(next.Servers[0].DbSslRootCert)
+	})
+	if diff := Diff(prev, next); !diff.Empty() {
+		t.Errorf("want empty diff; got %+v", diff)
+	}
+
+	// Temporary files of a discarded config are removed, without affecting others
+	next.RemoveTempFiles()
+	if _, err := os.Stat(next.Servers[0].DbSslRootCert); !os.IsNotExist(err) {
+		t.Errorf("want temporary file to be removed; got %v", err)
+	}
+	if _, err := os.Stat(prev.Servers[0].DbSslRootCert); err != nil {
+		t.Errorf("want temporary file of other config to be kept; got %v", err)
+	}
+}
+
+func TestWatchedFiles(t *testing.T) {
+	conf := Config{Servers: []ServerConfig{
+		{SectionFile: "/etc/pganalyze-collector.conf", DbPasswordFile: "/run/secrets/db_password"},
+		{SectionFile: "/etc/pganalyze-collector.d/other.conf", DbURLFile: "/run/secrets/db_url", ReferencedFiles: []string{"/run/secrets/api_key"}},
+		{SectionFile: "/etc/pganalyze-collector.conf", DbSslRootCert: "rds-ca-global", DbSslCert: "/etc/ssl/client.crt", DbSslKey: "/etc/ssl/client.key", AwsWebIdentityTokenFile: "/run/secrets/token"},
+		{SectionFile: "/etc/pganalyze-collector.conf", DbSslRootCert: filepath.Join(os.TempDir(), util.TempFilePrefix+"123")},
+	}}
+
+	files := conf.WatchedFiles("/etc/pganalyze-collector.conf")
+	expected := []string{
+		"/etc/pganalyze-collector.conf",
+		"/run/secrets/db_password",
+		"/etc/pganalyze-collector.d/other.conf",
+		"/run/secrets/db_url",
+		"/run/secrets/api_key",
+		"/etc/ssl/client.crt",
+		"/etc/ssl/client.key",
+	}
+	if !slices.Equal(files, expected) {
+		t.Errorf("want %v; got %v", expected, files)
+	}
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/go-ini/ini"
+)
+
+// Matches ${env:NAME} and ${file:/path/to/file} references in config values, as
+// well as the escaped form $${...}, which is kept as a literal ${...}
+var interpolationRegexp = regexp.MustCompile(`\$?\$\{(env|file):([^}]*)\}`)
+
+// interpolateSection - Resolves environment variable and file references in all
+// values of the section, so any setting (e.g. API keys or cloud provider
+// credentials) can be read from secret mounts or the environment
+//
+// This runs before the section is mapped to the config, so that references work
+// for settings of any type (e.g. "db_port = ${env:PGPORT}"). Returns the files
+// that were referenced.
+func interpolateSection(section *ini.Section) ([]string, error) {
+	var referencedFiles []string
+	for _, key := range section.Keys() {
+		value, files, err := interpolateValue(key.Value())
+		referencedFiles = append(referencedFiles, files...)
+		if err != nil {
+			return referencedFiles, fmt.Errorf("failed to resolve %s value: %v", key.Name(), err)
+		}
+		key.SetValue(value)
+	}
+	return referencedFiles, nil
+}
+
+// interpolateValue - Resolves references in a single value, and returns the
+// files that were referenced
+func interpolateValue(value string) (string, []string, error) {
+	if !strings.Contains(value, "${") {
+		return value, nil, nil
+	}
+
+	var files []string
+	var err error
+	result := interpolationRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+		if err != nil {
+			return ""
+		}
+		parts := interpolationRegexp.FindStringSubmatch(match)
+		source, name := parts[1], parts[2]
+		switch source {
+		case "env":
+			resolved, ok := os.LookupEnv(name)
+			if !ok {
+				err = fmt.Errorf("environment variable %s is not set", name)
+			}
+			return resolved
+		case "file":
+			files = append(files, name)
+			// Like other *_file settings, ignore surrounding whitespace (e.g. a trailing newline)
+			content, readErr := os.ReadFile(name)
+			if readErr != nil {
+				err = readErr
+			}
+			return strings.TrimSpace(string(content))
+		}
+		return match
+	})
+	if err != nil {
+		return "", files, err
+	}
+	return result, files, nil
+}
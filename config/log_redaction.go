@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// LogRedactionRule - User-defined rule for application-specific data (e.g.
+// email addresses) to be redacted from log lines and query sample parameters
+type LogRedactionRule struct {
+	Name   string
+	Regexp *regexp.Regexp
+}
+
+// ParseLogRedactionRules - Parses the filter_log_rules setting, which contains
+// one "name: regexp" rule per line (empty lines and lines starting with # are
+// ignored)
+func ParseLogRedactionRules(input string) ([]LogRedactionRule, error) {
+	var rules []LogRedactionRule
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, expr, ok := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		expr = strings.TrimSpace(expr)
+		if !ok || name == "" || expr == "" {
+			return nil, fmt.Errorf("invalid rule \"%s\", must be in the format \"name: regexp\"", line)
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression for rule \"%s\": %s", name, err)
+		}
+		rules = append(rules, LogRedactionRule{Name: name, Regexp: re})
+	}
+	return rules, nil
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestParseLogRedactionRules(t *testing.T) {
+	rules, err := ParseLogRedactionRules("email: [\\w.+-]+@[\\w-]+\\.[\\w.]+\n\n# Tenant tokens\ntenant_token:tt_[0-9a-f]{16}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].Name != "email" || rules[1].Name != "tenant_token" {
+		t.Fatalf("want rules email and tenant_token; got %+v", rules)
+	}
+	if !rules[0].Regexp.MatchString("jane.doe@example.com") || !rules[1].Regexp.MatchString("tt_0123456789abcdef") {
+		t.Errorf("want rules to match their examples")
+	}
+
+	for _, input := range []string{"email", ": [a-z]+", "email:", "email: (["} {
+		if _, err := ParseLogRedactionRules(input); err == nil {
+			t.Errorf("want error for rule \"%s\"", input)
+		}
+	}
+}
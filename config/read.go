@@ -9,7 +9,9 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -59,13 +61,20 @@ func parseConfigDisableCitusSchemaStats(value string) string {
 
 func getDefaultConfig() *ServerConfig {
 	config := &ServerConfig{
-		APIBaseURL:                 DefaultAPIBaseURL,
-		SectionName:                "default",
-		QueryStatsInterval:         60,
-		LogDownloadInterval:        MinLogDownloadInterval,
-		MaxCollectorConnections:    10,
-		MaxBufferCacheMonitoringGB: 200,
-		OtelServiceName:            DefaultOtelServiceName,
+		APIBaseURL:                  DefaultAPIBaseURL,
+		SectionName:                 "default",
+		QueryStatsInterval:          60,
+		LogDownloadInterval:         MinLogDownloadInterval,
+		MaxCollectorConnections:     10,
+		SchemaCollectionConcurrency: 4,
+		MaxBufferCacheMonitoringGB:  200,
+		OtelServiceName:             DefaultOtelServiceName,
+		SnapshotSpoolMaxSizeMB:      DefaultSnapshotSpoolMaxSizeMB,
+		LogArchiveMaxFileSizeMB:     DefaultLogArchiveMaxFileSizeMB,
+		VaultKvMount:                "secret",
+		VaultDatabaseMount:          "database",
+		LogKafkaConsumerGroup:       "pganalyze-collector",
+		LogKafkaFormat:              "stderr",
 	}
 
 	// The environment variables are the default way to configure when running inside a Docker container.
@@ -105,6 +114,9 @@ func getDefaultConfig() *ServerConfig {
 	if enableLogExplain := os.Getenv("PGA_ENABLE_LOG_EXPLAIN"); enableLogExplain != "" {
 		config.EnableLogExplain = parseConfigBool(enableLogExplain)
 	}
+	if enableLogQueryStats := os.Getenv("PGA_ENABLE_LOG_QUERY_STATS"); enableLogQueryStats != "" {
+		config.EnableLogQueryStats = parseConfigBool(enableLogQueryStats)
+	}
 	if dbURL := os.Getenv("DB_URL"); dbURL != "" {
 		config.DbURL = dbURL
 	}
@@ -153,6 +165,36 @@ func getDefaultConfig() *ServerConfig {
 	if dbUseIamAuth := os.Getenv("DB_USE_IAM_AUTH"); dbUseIamAuth != "" {
 		config.DbUseIamAuth = parseConfigBool(dbUseIamAuth)
 	}
+	if dbSecretProvider := os.Getenv("DB_SECRET_PROVIDER"); dbSecretProvider != "" {
+		config.DbSecretProvider = dbSecretProvider
+	}
+	if dbSecretRefreshInterval := os.Getenv("DB_SECRET_REFRESH_INTERVAL"); dbSecretRefreshInterval != "" {
+		config.DbSecretRefreshInterval = dbSecretRefreshInterval
+	}
+	if vaultAddr := os.Getenv("VAULT_ADDR"); vaultAddr != "" {
+		config.VaultAddr = vaultAddr
+	}
+	if vaultToken := os.Getenv("VAULT_TOKEN"); vaultToken != "" {
+		config.VaultToken = vaultToken
+	}
+	if vaultNamespace := os.Getenv("VAULT_NAMESPACE"); vaultNamespace != "" {
+		config.VaultNamespace = vaultNamespace
+	}
+	if vaultKvMount := os.Getenv("VAULT_KV_MOUNT"); vaultKvMount != "" {
+		config.VaultKvMount = vaultKvMount
+	}
+	if vaultSecretPath := os.Getenv("VAULT_SECRET_PATH"); vaultSecretPath != "" {
+		config.VaultSecretPath = vaultSecretPath
+	}
+	if vaultDatabaseMount := os.Getenv("VAULT_DATABASE_MOUNT"); vaultDatabaseMount != "" {
+		config.VaultDatabaseMount = vaultDatabaseMount
+	}
+	if vaultDatabaseRole := os.Getenv("VAULT_DATABASE_ROLE"); vaultDatabaseRole != "" {
+		config.VaultDatabaseRole = vaultDatabaseRole
+	}
+	if awsSecretsManagerSecretID := os.Getenv("AWS_SECRETS_MANAGER_SECRET_ID"); awsSecretsManagerSecretID != "" {
+		config.AwsSecretsManagerSecretID = awsSecretsManagerSecretID
+	}
 	if dbSslKeyContents := os.Getenv("DB_SSLKEY_CONTENTS"); dbSslKeyContents != "" {
 		config.DbSslKeyContents = dbSslKeyContents
 	}
@@ -208,6 +250,9 @@ func getDefaultConfig() *ServerConfig {
 	if awsEndpointCloudwatchLogsURL := os.Getenv("AWS_ENDPOINT_CLOUDWATCH_LOGS_URL"); awsEndpointCloudwatchLogsURL != "" {
 		config.AwsEndpointCloudwatchLogsURL = awsEndpointCloudwatchLogsURL
 	}
+	if awsEndpointSecretsManagerURL := os.Getenv("AWS_ENDPOINT_SECRETS_MANAGER_URL"); awsEndpointSecretsManagerURL != "" {
+		config.AwsEndpointSecretsManagerURL = awsEndpointSecretsManagerURL
+	}
 	if azureDbServerName := os.Getenv("AZURE_DB_SERVER_NAME"); azureDbServerName != "" {
 		config.AzureDbServerName = azureDbServerName
 	}
@@ -271,6 +316,18 @@ func getDefaultConfig() *ServerConfig {
 	if crunchyBridgeAPIKey := os.Getenv("CRUNCHY_BRIDGE_API_KEY"); crunchyBridgeAPIKey != "" {
 		config.CrunchyBridgeAPIKey = crunchyBridgeAPIKey
 	}
+	if aivenProjectID := os.Getenv("AIVEN_PROJECT_ID"); aivenProjectID != "" {
+		config.AivenProjectID = aivenProjectID
+	}
+	if aivenServiceID := os.Getenv("AIVEN_SERVICE_ID"); aivenServiceID != "" {
+		config.AivenServiceID = aivenServiceID
+	}
+	if aivenAPIToken := os.Getenv("AIVEN_API_TOKEN"); aivenAPIToken != "" {
+		config.AivenAPIToken = aivenAPIToken
+	}
+	if aivenAPIURL := os.Getenv("AIVEN_API_URL"); aivenAPIURL != "" {
+		config.AivenAPIURL = aivenAPIURL
+	}
 	if temboNamespace := os.Getenv("TEMBO_NAMESPACE"); temboNamespace != "" {
 		config.TemboNamespace = temboNamespace
 	}
@@ -343,6 +400,36 @@ func getDefaultConfig() *ServerConfig {
 	if logOtelK8SLabels := os.Getenv("LOG_OTEL_K8S_LABELS"); logOtelK8SLabels != "" {
 		config.LogOtelK8SLabels = logOtelK8SLabels
 	}
+	if logKafkaBrokers := os.Getenv("LOG_KAFKA_BROKERS"); logKafkaBrokers != "" {
+		config.LogKafkaBrokers = logKafkaBrokers
+	}
+	if logKafkaTopic := os.Getenv("LOG_KAFKA_TOPIC"); logKafkaTopic != "" {
+		config.LogKafkaTopic = logKafkaTopic
+	}
+	if logKafkaConsumerGroup := os.Getenv("LOG_KAFKA_CONSUMER_GROUP"); logKafkaConsumerGroup != "" {
+		config.LogKafkaConsumerGroup = logKafkaConsumerGroup
+	}
+	if logKafkaFormat := os.Getenv("LOG_KAFKA_FORMAT"); logKafkaFormat != "" {
+		config.LogKafkaFormat = logKafkaFormat
+	}
+	if logKafkaSaslMechanism := os.Getenv("LOG_KAFKA_SASL_MECHANISM"); logKafkaSaslMechanism != "" {
+		config.LogKafkaSaslMechanism = logKafkaSaslMechanism
+	}
+	if logKafkaSaslUsername := os.Getenv("LOG_KAFKA_SASL_USERNAME"); logKafkaSaslUsername != "" {
+		config.LogKafkaSaslUsername = logKafkaSaslUsername
+	}
+	if logKafkaSaslPassword := os.Getenv("LOG_KAFKA_SASL_PASSWORD"); logKafkaSaslPassword != "" {
+		config.LogKafkaSaslPassword = logKafkaSaslPassword
+	}
+	if logKafkaTLS := os.Getenv("LOG_KAFKA_TLS"); logKafkaTLS != "" {
+		config.LogKafkaTLS = parseConfigBool(logKafkaTLS)
+	}
+	if logKafkaTLSCAFile := os.Getenv("LOG_KAFKA_TLS_CA_FILE"); logKafkaTLSCAFile != "" {
+		config.LogKafkaTLSCAFile = logKafkaTLSCAFile
+	}
+	if logKafkaTLSCAContents := os.Getenv("LOG_KAFKA_TLS_CA_CONTENTS"); logKafkaTLSCAContents != "" {
+		config.LogKafkaTLSCAContents = logKafkaTLSCAContents
+	}
 	if logTestTimeout := os.Getenv("LOG_TEST_TIMEOUT"); logTestTimeout != "" {
 		config.LogTestTimeoutSecs, _ = strconv.Atoi(logTestTimeout)
 	}
@@ -355,6 +442,9 @@ func getDefaultConfig() *ServerConfig {
 	if logPgReadFile := os.Getenv("LOG_PG_READ_FILE"); logPgReadFile != "" {
 		config.LogPgReadFile = parseConfigBool(logPgReadFile)
 	}
+	if logAwsCloudwatchLogs := os.Getenv("LOG_AWS_CLOUDWATCH_LOGS"); logAwsCloudwatchLogs != "" {
+		config.LogAwsCloudwatchLogs = parseConfigBool(logAwsCloudwatchLogs)
+	}
 	if logDownloadInterval := os.Getenv("LOG_DOWNLOAD_INTERVAL"); logDownloadInterval != "" {
 		parsed, err := strconv.ParseInt(logDownloadInterval, 10, 32)
 		if err == nil {
@@ -380,6 +470,9 @@ func getDefaultConfig() *ServerConfig {
 	if maxCollectorConnections := os.Getenv("MAX_COLLECTOR_CONNECTION"); maxCollectorConnections != "" {
 		config.MaxCollectorConnections, _ = strconv.Atoi(maxCollectorConnections)
 	}
+	if schemaCollectionConcurrency := os.Getenv("SCHEMA_COLLECTION_CONCURRENCY"); schemaCollectionConcurrency != "" {
+		config.SchemaCollectionConcurrency, _ = strconv.Atoi(schemaCollectionConcurrency)
+	}
 	if skipIfReplica := os.Getenv("SKIP_IF_REPLICA"); skipIfReplica != "" {
 		config.SkipIfReplica = parseConfigBool(skipIfReplica)
 	}
@@ -397,6 +490,9 @@ func getDefaultConfig() *ServerConfig {
 	if filterQueryText := os.Getenv("FILTER_QUERY_TEXT"); filterQueryText != "" {
 		config.FilterQueryText = filterQueryText
 	}
+	if filterLogRules := os.Getenv("FILTER_LOG_RULES"); filterLogRules != "" {
+		config.FilterLogRules = filterLogRules
+	}
 	if otelExporterOtlpEndpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); otelExporterOtlpEndpoint != "" {
 		config.OtelExporterOtlpEndpoint = otelExporterOtlpEndpoint
 	}
@@ -427,6 +523,42 @@ func getDefaultConfig() *ServerConfig {
 	if apiRequireWebSocket := os.Getenv("API_REQUIRE_WEBSOCKET"); apiRequireWebSocket != "" {
 		config.APIRequireWebsocket = parseConfigBool(apiRequireWebSocket)
 	}
+	if dbConnectionIdleTimeout := os.Getenv("DB_CONNECTION_IDLE_TIMEOUT"); dbConnectionIdleTimeout != "" {
+		config.DbConnectionIdleTimeout = dbConnectionIdleTimeout
+	}
+	if snapshotSpoolDir := os.Getenv("SNAPSHOT_SPOOL_DIR"); snapshotSpoolDir != "" {
+		config.SnapshotSpoolDir = snapshotSpoolDir
+	}
+	if snapshotSpoolMaxSizeMB := os.Getenv("SNAPSHOT_SPOOL_MAX_SIZE_MB"); snapshotSpoolMaxSizeMB != "" {
+		config.SnapshotSpoolMaxSizeMB, _ = strconv.Atoi(snapshotSpoolMaxSizeMB)
+	}
+	if snapshotSpoolMaxAge := os.Getenv("SNAPSHOT_SPOOL_MAX_AGE"); snapshotSpoolMaxAge != "" {
+		config.SnapshotSpoolMaxAge = snapshotSpoolMaxAge
+	}
+	if logArchiveDir := os.Getenv("LOG_ARCHIVE_DIR"); logArchiveDir != "" {
+		config.LogArchiveDir = logArchiveDir
+	}
+	if logArchiveMaxFileSizeMB := os.Getenv("LOG_ARCHIVE_MAX_FILE_SIZE_MB"); logArchiveMaxFileSizeMB != "" {
+		config.LogArchiveMaxFileSizeMB, _ = strconv.Atoi(logArchiveMaxFileSizeMB)
+	}
+	if logArchiveRotateInterval := os.Getenv("LOG_ARCHIVE_ROTATE_INTERVAL"); logArchiveRotateInterval != "" {
+		config.LogArchiveRotateInterval = logArchiveRotateInterval
+	}
+	if logArchiveMaxFiles := os.Getenv("LOG_ARCHIVE_MAX_FILES"); logArchiveMaxFiles != "" {
+		config.LogArchiveMaxFiles, _ = strconv.Atoi(logArchiveMaxFiles)
+	}
+	if metricsListenAddress := os.Getenv("METRICS_LISTEN_ADDRESS"); metricsListenAddress != "" {
+		config.MetricsListenAddress = metricsListenAddress
+	}
+	if healthListenAddress := os.Getenv("HEALTH_LISTEN_ADDRESS"); healthListenAddress != "" {
+		config.HealthListenAddress = healthListenAddress
+	}
+	if healthFullSnapshotMaxAge := os.Getenv("HEALTH_FULL_SNAPSHOT_MAX_AGE"); healthFullSnapshotMaxAge != "" {
+		config.HealthFullSnapshotMaxAge = healthFullSnapshotMaxAge
+	}
+	if healthLogMaxAge := os.Getenv("HEALTH_LOG_MAX_AGE"); healthLogMaxAge != "" {
+		config.HealthLogMaxAge = healthLogMaxAge
+	}
 
 	return config
 }
@@ -713,6 +845,92 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 		}
 	}
 
+	if config.FilterLogRules != "" {
+		config.FilterLogRulesParsed, err = ParseLogRedactionRules(config.FilterLogRules)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse filter_log_rules: %v", err)
+		}
+	}
+
+	if config.DbConnectionIdleTimeout != "" {
+		config.DbConnectionIdleTimeoutParsed, err = time.ParseDuration(config.DbConnectionIdleTimeout)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse database connection idle timeout value: %v", err)
+		}
+	} else {
+		config.DbConnectionIdleTimeoutParsed = DefaultDbConnectionIdleTimeout
+	}
+
+	if config.DbSecretRefreshInterval != "" {
+		config.DbSecretRefreshIntervalParsed, err = time.ParseDuration(config.DbSecretRefreshInterval)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse database secret refresh interval value: %v", err)
+		}
+	} else {
+		config.DbSecretRefreshIntervalParsed = DefaultDbSecretRefreshInterval
+	}
+	if config.DbSecretProvider != "" && config.DbUseIamAuth {
+		return config, fmt.Errorf("db_secret_provider and db_use_iam_auth can not be used together")
+	}
+	switch config.DbSecretProvider {
+	case "":
+	case "vault_kv":
+		if config.VaultAddr == "" || config.VaultSecretPath == "" {
+			return config, fmt.Errorf("the vault_kv secret provider requires vault_addr and vault_secret_path to be set")
+		}
+	case "vault_database":
+		if config.VaultAddr == "" || config.VaultDatabaseRole == "" {
+			return config, fmt.Errorf("the vault_database secret provider requires vault_addr and vault_database_role to be set")
+		}
+	case "aws_secrets_manager":
+		if config.AwsSecretsManagerSecretID == "" {
+			return config, fmt.Errorf("the aws_secrets_manager secret provider requires aws_secrets_manager_secret_id to be set")
+		}
+	default:
+		return config, fmt.Errorf("unsupported db_secret_provider value: %s", config.DbSecretProvider)
+	}
+
+	if config.SnapshotSpoolMaxAge != "" {
+		config.SnapshotSpoolMaxAgeParsed, err = time.ParseDuration(config.SnapshotSpoolMaxAge)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse snapshot spool max age value: %v", err)
+		}
+	} else {
+		config.SnapshotSpoolMaxAgeParsed = DefaultSnapshotSpoolMaxAge
+	}
+	if config.SnapshotSpoolMaxSizeMB <= 0 {
+		config.SnapshotSpoolMaxSizeMB = DefaultSnapshotSpoolMaxSizeMB
+	}
+
+	if config.LogArchiveRotateInterval != "" {
+		config.LogArchiveRotateIntervalParsed, err = time.ParseDuration(config.LogArchiveRotateInterval)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse log archive rotate interval value: %v", err)
+		} else if config.LogArchiveRotateIntervalParsed <= 0 {
+			return config, fmt.Errorf("log archive rotate interval must be positive, but is set to %s", config.LogArchiveRotateInterval)
+		}
+	} else {
+		config.LogArchiveRotateIntervalParsed = DefaultLogArchiveRotateInterval
+	}
+	if config.LogArchiveMaxFileSizeMB <= 0 {
+		config.LogArchiveMaxFileSizeMB = DefaultLogArchiveMaxFileSizeMB
+	}
+
+	if config.HealthFullSnapshotMaxAge != "" {
+		config.HealthFullSnapshotMaxAgeParsed, err = time.ParseDuration(config.HealthFullSnapshotMaxAge)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse health full snapshot max age value: %v", err)
+		}
+	} else {
+		config.HealthFullSnapshotMaxAgeParsed = DefaultHealthFullSnapshotMaxAge
+	}
+	if config.HealthLogMaxAge != "" {
+		config.HealthLogMaxAgeParsed, err = time.ParseDuration(config.HealthLogMaxAge)
+		if err != nil {
+			return config, fmt.Errorf("failed to parse health log max age value: %v", err)
+		}
+	}
+
 	dbNameParts := []string{}
 	for _, s := range strings.Split(config.DbName, ",") {
 		dbNameParts = append(dbNameParts, strings.TrimSpace(s))
@@ -801,6 +1019,13 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 		}
 	}
 
+	if config.LogKafkaTLSCAContents != "" {
+		config.LogKafkaTLSCAFile, err = writeValueToTempfile(config.LogKafkaTLSCAContents)
+		if err != nil {
+			return config, err
+		}
+	}
+
 	if config.LogOtelServer != "" {
 		if config.LogOtelK8SPod != "" {
 			parts := strings.SplitN(config.LogOtelK8SPod, "/", 2)
@@ -826,6 +1051,22 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 		}
 	}
 
+	if config.LogKafkaTopic != "" {
+		if config.LogKafkaBrokers == "" {
+			return config, fmt.Errorf("db_log_kafka_brokers must be set when using db_log_kafka_topic")
+		}
+		switch config.LogKafkaFormat {
+		case "stderr", "csvlog", "jsonlog":
+		default:
+			return config, fmt.Errorf("db_log_kafka_format must be one of stderr, csvlog or jsonlog, but is set to \"%s\"", config.LogKafkaFormat)
+		}
+		switch config.LogKafkaSaslMechanism {
+		case "", "plain", "scram-sha-256", "scram-sha-512":
+		default:
+			return config, fmt.Errorf("db_log_kafka_sasl_mechanism must be one of plain, scram-sha-256 or scram-sha-512, but is set to \"%s\"", config.LogKafkaSaslMechanism)
+		}
+	}
+
 	if config.LogDownloadInterval == 0 {
 		// Not set at all (e.g. for a config that was constructed programmatically,
 		// instead of coming from getDefaultConfig)
@@ -854,61 +1095,167 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 	return config, nil
 }
 
-// Read - Reads the configuration from the specified filename, or fall back to the default config
+// Section in the main config file, or a file in its include directory
+type configSection struct {
+	section  *ini.Section
+	filename string
+}
+
+// loadConfigFiles - Loads the main config file, and the files matched by the
+// include_dir setting in its [pganalyze] section (e.g. "/etc/pganalyze-collector.d",
+// which is the same as "/etc/pganalyze-collector.d/*.conf"), in lexical order
+//
+// Included files may only contain server sections, which inherit the [pganalyze]
+// settings of the main file. Each server section name must be unique across files.
+// The glob pattern used for included files is returned as well (empty if unset).
+func loadConfigFiles(filename string) (*ini.Section, []configSection, string, error) {
+	loadOptions := ini.LoadOptions{SpaceBeforeInlineComment: true}
+
+	configFile, err := ini.LoadSources(loadOptions, filename)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	pgaSection, err := configFile.GetSection("pganalyze")
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("Failed to find [pganalyze] section in config: %s", err)
+	}
+
+	var sections []configSection
+	sectionFiles := make(map[string]string)
+	addSections := func(file *ini.File, filename string) error {
+		for _, section := range file.Sections() {
+			sectionName := section.Name()
+			if sectionName == ini.DefaultSection {
+				// we don't use the default section
+				continue
+			}
+			if sectionName == "pganalyze" {
+				if sectionFiles[sectionName] == "" {
+					// we handle the main file's pganalyze section separately
+					sectionFiles[sectionName] = filename
+					continue
+				}
+				return fmt.Errorf("Found [pganalyze] section in %s, but it is only allowed in the main config file %s", filename, sectionFiles[sectionName])
+			}
+			if otherFilename, ok := sectionFiles[sectionName]; ok {
+				return fmt.Errorf("Duplicate section [%s] found in %s, already defined in %s", sectionName, filename, otherFilename)
+			}
+			sectionFiles[sectionName] = filename
+			sections = append(sections, configSection{section: section, filename: filename})
+		}
+		return nil
+	}
+
+	err = addSections(configFile, filename)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	includeDir := pgaSection.Key("include_dir").String()
+	if includeDir == "" {
+		return pgaSection, sections, "", nil
+	}
+	pattern := includeDir
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(filepath.Dir(filename), pattern)
+	}
+	if info, err := os.Stat(pattern); err == nil && info.IsDir() {
+		pattern = filepath.Join(pattern, "*.conf")
+	}
+	includedFilenames, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("Failed to parse include_dir value \"%s\": %s", includeDir, err)
+	}
+	for _, includedFilename := range includedFilenames {
+		if sameFile(includedFilename, filename) {
+			continue
+		}
+		includedFile, err := ini.LoadSources(loadOptions, includedFilename)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("Failed to read included config file %s: %s", includedFilename, err)
+		}
+		err = addSections(includedFile, includedFilename)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	return pgaSection, sections, pattern, nil
+}
+
+func sameFile(a string, b string) bool {
+	aInfo, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	bInfo, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(aInfo, bInfo)
+}
+
+// Read - Reads the configuration from the specified filename (and its include directory,
+// if configured), or fall back to the default config
 func Read(testRun bool, logger *util.Logger, filename string) (Config, error) {
 	var conf Config
 	var err error
 
 	if _, err = os.Stat(filename); err == nil {
-		configFile, err := ini.LoadSources(ini.LoadOptions{SpaceBeforeInlineComment: true}, filename)
+		pgaSection, sections, includePattern, err := loadConfigFiles(filename)
 		if err != nil {
 			return conf, err
 		}
+		conf.IncludePattern = includePattern
 
 		defaultConfig := getDefaultConfig()
 
-		pgaSection, err := configFile.GetSection("pganalyze")
+		defaultConfig.ReferencedFiles, err = interpolateSection(pgaSection)
 		if err != nil {
-			return conf, fmt.Errorf("Failed to find [pganalyze] section in config: %s", err)
+			return conf, fmt.Errorf("Failed to process [pganalyze] section in config: %s", err)
 		}
 		err = pgaSection.MapTo(defaultConfig)
 		if err != nil {
 			return conf, fmt.Errorf("Failed to map [pganalyze] section in config: %s", err)
 		}
 
-		sections := configFile.Sections()
-		for _, section := range sections {
+		for _, s := range sections {
+			section := s.section
 			sectionName := section.Name()
-			if sectionName == "pganalyze" || sectionName == ini.DefaultSection {
-				// we already handled the pganalyze section above, and we don't use the default section
-				continue
-			}
 			config := &ServerConfig{}
 			*config = *defaultConfig
 
+			referencedFiles, err := interpolateSection(section)
+			if err != nil {
+				return conf, fmt.Errorf("Failed to process section [%s] in %s: %s", sectionName, s.filename, err)
+			}
+			config.ReferencedFiles = append(slices.Clone(defaultConfig.ReferencedFiles), referencedFiles...)
+
 			err = section.MapTo(config)
 			if err != nil {
-				return conf, err
+				return conf, fmt.Errorf("Failed to map section [%s] in %s: %s", sectionName, s.filename, err)
 			}
 
 			config, err = preprocessConfig(config)
 			if err != nil {
-				return conf, err
+				return conf, fmt.Errorf("Failed to process section [%s] in %s: %s", sectionName, s.filename, err)
 			}
 
 			if config.DbURL != "" {
 				_, err := url.Parse(config.DbURL)
 				if err != nil {
-					logger.PrintError("Could not parse db_url in section %s; check URL format and note that any special characters must be percent-encoded", config.SectionName)
+					logger.PrintError("Could not parse db_url in section %s (in %s); check URL format and note that any special characters must be percent-encoded", sectionName, s.filename)
 				}
 			}
 
 			if config.GetDbName() == "" {
-				logger.PrintError("No connection info found for section %s; see https://pganalyze.com/docs/collector/settings", sectionName)
+				logger.PrintError("No connection info found for section %s (in %s); see https://pganalyze.com/docs/collector/settings", sectionName, s.filename)
 				continue
 			}
 
 			config.SectionName = sectionName
+			config.SectionFile = s.filename
 			config.SystemID, config.SystemType, config.SystemScope, config.SystemIDFallback, config.SystemTypeFallback, config.SystemScopeFallback = identifySystem(*config)
 
 			config.Identifier = ServerIdentifier{
@@ -922,7 +1269,7 @@ func Read(testRun bool, logger *util.Logger, filename string) (Config, error) {
 			// Ensure we have no duplicate identifiers within one collector
 			for _, server := range conf.Servers {
 				if config.Identifier == server.Identifier {
-					error := fmt.Sprintf("Duplicate servers detected: %s and %s. To monitor multiple databases on the same server, db_name accepts a comma-separated list", server.SectionName, config.SectionName)
+					error := fmt.Sprintf("Duplicate servers detected: %s (in %s) and %s (in %s). To monitor multiple databases on the same server, db_name accepts a comma-separated list", server.SectionName, server.SectionFile, config.SectionName, config.SectionFile)
 					if testRun {
 						return conf, errors.New(error)
 					} else {
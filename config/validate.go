@@ -0,0 +1,219 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ValidationIssue - Problem found when validating the configuration
+type ValidationIssue struct {
+	File    string `json:"file,omitempty"`
+	Section string `json:"section,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+}
+
+// ValidatedServer - Server identified from a valid config section
+type ValidatedServer struct {
+	Section     string `json:"section"`
+	File        string `json:"file"`
+	SystemID    string `json:"system_id"`
+	SystemType  string `json:"system_type"`
+	SystemScope string `json:"system_scope"`
+}
+
+// ValidationResult - Result of validating the configuration, intended to be
+// output as JSON
+type ValidationResult struct {
+	Valid   bool              `json:"valid"`
+	Servers []ValidatedServer `json:"servers"`
+	Issues  []ValidationIssue `json:"issues"`
+}
+
+// Valid values for filter_log_secret
+var filterLogSecretValues = append([]string{"none", "all"}, FilterLogSecretKinds...)
+
+// Validate - Checks the configuration in the specified file (and its include
+// directory, if configured) without connecting to any databases or APIs
+//
+// In addition to the checks done when reading the config, this reports settings
+// that would otherwise be silently ignored (unknown or unparsable settings), as
+// well as conflicting settings.
+func Validate(filename string) ValidationResult {
+	result := ValidationResult{Servers: []ValidatedServer{}, Issues: []ValidationIssue{}}
+	addIssue := func(file string, section string, key string, format string, args ...any) {
+		result.Issues = append(result.Issues, ValidationIssue{File: file, Section: section, Key: key, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if _, err := os.Stat(filename); err != nil {
+		addIssue(filename, "", "", "Could not read config file: %s", err)
+		return result
+	}
+	pgaSection, sections, _, err := loadConfigFiles(filename)
+	if err != nil {
+		addIssue(filename, "", "", "%s", err)
+		return result
+	}
+
+	knownKeys := configKeys()
+	for _, key := range pgaSection.Keys() {
+		if !knownKeys[key.Name()] && key.Name() != "include_dir" {
+			addIssue(filename, "pganalyze", key.Name(), "Unknown setting")
+		}
+	}
+
+	defaultConfig := getDefaultConfig()
+	if _, err = interpolateSection(pgaSection); err != nil {
+		addIssue(filename, "pganalyze", "", "%s", err)
+	}
+	if err = pgaSection.StrictMapTo(defaultConfig); err != nil {
+		addIssue(filename, "pganalyze", "", "Invalid setting value: %s", err)
+		pgaSection.MapTo(defaultConfig)
+	}
+
+	identifiers := make(map[ServerIdentifier]ValidatedServer)
+	for _, s := range sections {
+		sectionName := s.section.Name()
+		for _, key := range s.section.Keys() {
+			if !knownKeys[key.Name()] {
+				addIssue(s.filename, sectionName, key.Name(), "Unknown setting")
+			}
+		}
+
+		config := &ServerConfig{}
+		*config = *defaultConfig
+		if _, err = interpolateSection(s.section); err != nil {
+			addIssue(s.filename, sectionName, "", "%s", err)
+			continue
+		}
+		if err = s.section.StrictMapTo(config); err != nil {
+			addIssue(s.filename, sectionName, "", "Invalid setting value: %s", err)
+			s.section.MapTo(config)
+		}
+
+		validateServerConfig(*config, func(key string, format string, args ...any) {
+			addIssue(s.filename, sectionName, key, format, args...)
+		})
+
+		// This also checks value ranges, e.g. for log_download_interval
+		unprocessedConfig := *config
+		config, err = preprocessConfig(config)
+		removeConfigTempFiles(unprocessedConfig, *config)
+		if err != nil {
+			addIssue(s.filename, sectionName, "", "%s", err)
+			continue
+		}
+		if config.GetDbName() == "" {
+			addIssue(s.filename, sectionName, "", "No connection info found (db_url or db_name need to be set)")
+			continue
+		}
+
+		config.SystemID, config.SystemType, config.SystemScope, _, _, _ = identifySystem(*config)
+		server := ValidatedServer{
+			Section:     sectionName,
+			File:        s.filename,
+			SystemID:    config.SystemID,
+			SystemType:  config.SystemType,
+			SystemScope: config.SystemScope,
+		}
+		identifier := ServerIdentifier{
+			APIKey:      config.APIKey,
+			APIBaseURL:  config.APIBaseURL,
+			SystemID:    config.SystemID,
+			SystemType:  config.SystemType,
+			SystemScope: config.SystemScope,
+		}
+		if other, ok := identifiers[identifier]; ok {
+			addIssue(s.filename, sectionName, "", "Duplicate server: identified as the same server as section [%s] (in %s). To monitor multiple databases on the same server, db_name accepts a comma-separated list", other.Section, other.File)
+		} else {
+			identifiers[identifier] = server
+		}
+		result.Servers = append(result.Servers, server)
+	}
+
+	if len(sections) == 0 {
+		addIssue(filename, "", "", "Configuration contains no server sections")
+	}
+
+	result.Valid = len(result.Issues) == 0
+	return result
+}
+
+// Checks for settings that are accepted when reading the config, but are ignored
+// or would cause problems at runtime
+func validateServerConfig(config ServerConfig, addIssue func(key string, format string, args ...any)) {
+	var logSources []string
+	if config.LogLocation != "" {
+		logSources = append(logSources, "db_log_location")
+	}
+	if config.LogDockerTail != "" {
+		logSources = append(logSources, "db_log_docker_tail")
+	}
+	if config.LogSyslogServer != "" {
+		logSources = append(logSources, "db_log_syslog_server")
+	}
+	if config.LogOtelServer != "" {
+		logSources = append(logSources, "db_log_otel_server")
+	}
+	if config.LogKafkaTopic != "" {
+		logSources = append(logSources, "db_log_kafka_topic")
+	}
+	if config.LogPgReadFile {
+		logSources = append(logSources, "db_log_pg_read_file")
+	}
+	if config.AzureEventhubNamespace != "" || config.AzureEventhubName != "" {
+		logSources = append(logSources, "azure_eventhub_namespace/azure_eventhub_name")
+	}
+	if config.GcpPubsubSubscription != "" {
+		logSources = append(logSources, "gcp_pubsub_subscription")
+	}
+	if len(logSources) > 1 {
+		addIssue("", "Only one log source can be used for a server, but found multiple: %s", strings.Join(logSources, ", "))
+	}
+
+	if config.IgnoreSchemaRegexp != "" {
+		if _, err := regexp.Compile(config.IgnoreSchemaRegexp); err != nil {
+			addIssue("ignore_schema_regexp", "Invalid regular expression: %s", err)
+		}
+	}
+
+	for _, value := range strings.Split(config.FilterLogSecret, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(filterLogSecretValues, value) {
+			addIssue("filter_log_secret", "Invalid value \"%s\", must be a comma-separated list of: %s", value, strings.Join(filterLogSecretValues, ", "))
+		}
+	}
+}
+
+// Returns the names of all settings that can be used in a server section
+func configKeys() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(ServerConfig{})
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("ini")
+		if key != "" && key != "-" {
+			keys[key] = true
+		}
+	}
+	return keys
+}
+
+// Removes temporary files written when processing the config (e.g. for
+// db_sslcert_contents), since the config is only validated and never used
+func removeConfigTempFiles(unprocessed ServerConfig, processed ServerConfig) {
+	before := reflect.ValueOf(unprocessed)
+	after := reflect.ValueOf(processed)
+	for i := 0; i < after.NumField(); i++ {
+		if after.Field(i).Kind() != reflect.String {
+			continue
+		}
+		path := after.Field(i).String()
+		if path != before.Field(i).String() && isConfigTempFile(path) {
+			os.Remove(path)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	type testItem struct {
+		name     string
+		content  string
+		expected []ValidationIssue
+	}
+
+	tests := []testItem{
+		{
+			"valid",
+			"[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_log_location = /var/log/postgresql\n",
+			[]ValidationIssue{},
+		},
+		{
+			"unknown settings",
+			"[pganalyze]\napi_key = abc\napi_keys = def\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_pasword = secret\n",
+			[]ValidationIssue{
+				{Section: "pganalyze", Key: "api_keys", Message: "Unknown setting"},
+				{Section: "main", Key: "db_pasword", Message: "Unknown setting"},
+			},
+		},
+		{
+			"invalid values",
+			"[pganalyze]\napi_key = abc\nfilter_log_secret = credential, secrets\n\n[main]\ndb_host = db.example.com\ndb_name = app\nignore_schema_regexp = ^(foo\n",
+			[]ValidationIssue{
+				{Section: "main", Key: "ignore_schema_regexp", Message: "Invalid regular expression: error parsing regexp: missing closing ): `^(foo`"},
+				{Section: "main", Key: "filter_log_secret", Message: "Invalid value \"secrets\", must be a comma-separated list of: none, all, credential, parsing_error, statement_text, statement_parameter, table_data, ops, unidentified"},
+			},
+		},
+		{
+			"empty filter_log_secret",
+			"[pganalyze]\napi_key = abc\nfilter_log_secret =\n\n[main]\ndb_host = db.example.com\ndb_name = app\n",
+			[]ValidationIssue{},
+		},
+		{
+			"unparsable value",
+			"[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_port = five\n",
+			[]ValidationIssue{
+				{Section: "main", Message: "Invalid setting value: set field \"db_port\": strconv.ParseInt: parsing \"five\": invalid syntax"},
+			},
+		},
+		{
+			"out of range log download interval",
+			"[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\nlog_download_interval = 1000\n",
+			[]ValidationIssue{
+				{Section: "main", Message: "log_download_interval must be between 30 and 600 seconds, but is set to 1000"},
+			},
+		},
+		{
+			"invalid filter_log_rules",
+			"[pganalyze]\napi_key = abc\nfilter_log_rules = \"\"\"\nemail: [a-z]+@[a-z.]+\ntoken: tok_(\n\"\"\"\n\n[main]\ndb_host = db.example.com\ndb_name = app\n",
+			[]ValidationIssue{
+				{Section: "main", Message: "failed to parse filter_log_rules: invalid regular expression for rule \"token\": error parsing regexp: missing closing ): `tok_(`"},
+			},
+		},
+		{
+			"multiple log sources",
+			"[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_log_location = /var/log/postgresql\ndb_log_otel_server = 0.0.0.0:4318\n",
+			[]ValidationIssue{
+				{Section: "main", Message: "Only one log source can be used for a server, but found multiple: db_log_location, db_log_otel_server"},
+			},
+		},
+		{
+			"duplicate servers",
+			"[pganalyze]\napi_key = abc\n\n[first]\ndb_host = db.example.com\ndb_name = app\n\n[second]\ndb_host = db.example.com\ndb_name = app\ndb_username = other\n",
+			[]ValidationIssue{
+				{Section: "second", Message: "Duplicate server: identified as the same server as section [first] (in $FILE). To monitor multiple databases on the same server, db_name accepts a comma-separated list"},
+			},
+		},
+		{
+			"no servers",
+			"[pganalyze]\napi_key = abc\n",
+			[]ValidationIssue{
+				{Message: "Configuration contains no server sections"},
+			},
+		},
+	}
+
+	for _, item := range tests {
+		filename := filepath.Join(t.TempDir(), "pganalyze-collector.conf")
+		err := os.WriteFile(filename, []byte(item.content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for idx := range item.expected {
+			item.expected[idx].File = filename
+			item.expected[idx].Message = strings.ReplaceAll(item.expected[idx].Message, "$FILE", filename)
+		}
+
+		result := Validate(filename)
+		if !reflect.DeepEqual(result.Issues, item.expected) {
+			t.Errorf("%s: want issues %+v; got %+v", item.name, item.expected, result.Issues)
+		}
+		if result.Valid != (len(item.expected) == 0) {
+			t.Errorf("%s: want valid = %t; got %t", item.name, len(item.expected) == 0, result.Valid)
+		}
+	}
+}
+
+func TestValidateServers(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "pganalyze-collector.conf")
+	err := os.WriteFile(filename, []byte("[pganalyze]\napi_key = abc\n\n[main]\ndb_host = mydb.abc123.us-east-1.rds.amazonaws.com\ndb_name = app\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := Validate(filename)
+	expected := []ValidatedServer{{Section: "main", File: filename, SystemID: "mydb", SystemType: "amazon_rds", SystemScope: "us-east-1/abc123"}}
+	if !result.Valid || !reflect.DeepEqual(result.Servers, expected) {
+		t.Errorf("want valid result with servers %+v; got %+v", expected, result)
+	}
+}
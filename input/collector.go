@@ -3,8 +3,10 @@ package input
 import (
 	"os"
 	"runtime"
+	"time"
 
 	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs/stream"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 	"github.com/shirou/gopsutil/host"
@@ -30,6 +32,7 @@ func getMemoryRssBytes() uint64 {
 func getCollectorStats() state.CollectorStats {
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
+	streamStats := stream.GetStats()
 
 	return state.CollectorStats{
 		GoVersion:                runtime.Version(),
@@ -39,6 +42,11 @@ func getCollectorStats() state.CollectorStats {
 		MemoryHeapObjects:        memStats.HeapObjects,
 		MemorySystemBytes:        memStats.Sys,
 		MemoryRssBytes:           getMemoryRssBytes(),
+		LogStreamQueueDepth:      streamStats.QueueDepth,
+		LogStreamAnalysisRuns:    streamStats.AnalysisRuns,
+		LogStreamAnalysisTimeMs:  float64(streamStats.AnalysisTime) / float64(time.Millisecond),
+		LogStreamAnalyzedLines:   streamStats.AnalyzedLines,
+		LogStreamDroppedLines:    streamStats.DroppedLines,
 	}
 }
 
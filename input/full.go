@@ -81,9 +81,11 @@ func CollectFull(ctx context.Context, server *state.Server, connection *sql.DB,
 		ts.StatementStats = newHighFreqState.UnidentifiedStatementStats
 		ts.PlanStats = newHighFreqState.UnidentifiedPlanStats
 		ts.ServerIoStats = newHighFreqState.QueuedServerIoStats
+		ts.ServerSharedStats = newHighFreqState.QueuedServerSharedStats
 		newHighFreqState.UnidentifiedStatementStats = make(state.HistoricStatementStatsMap)
 		newHighFreqState.UnidentifiedPlanStats = make(state.HistoricPlanStatsMap)
 		newHighFreqState.QueuedServerIoStats = make(state.HistoricPostgresServerIoStatsMap)
+		newHighFreqState.QueuedServerSharedStats = make(state.HistoricPostgresServerSharedStatsMap)
 		server.HighFreqPrevState = newHighFreqState
 	}
 	server.HighFreqStateMutex.Unlock()
@@ -185,6 +187,10 @@ func CollectFull(ctx context.Context, server *state.Server, connection *sql.DB,
 		server.HighFreqStateMutex.Unlock()
 	}
 
+	if server.LogQueryStats != nil {
+		ts = addLogQueryStats(server, ps.CollectedAt, ts, logger)
+	}
+
 	// CollectAllSchemas relies on GetBufferCache to access the filenode OIDs before that data is discarded
 	select {
 	case <-ctx.Done():
@@ -31,6 +31,14 @@ func CollectAndDiff1minStats(ctx context.Context, c *postgres.Collection, connec
 		return newState, errors.Wrap(err, "error collecting Postgres server statistics")
 	}
 
+	newState.ServerSharedStats, err = postgres.GetServerSharedStats(ctx, c, connection)
+	if err != nil {
+		// These views may be unavailable (e.g. due to missing permissions), which
+		// should not prevent collecting query statistics
+		c.Logger.PrintWarning("Skipping WAL and checkpoint statistics, due to error: %s", err)
+		newState.ServerSharedStats = state.PostgresServerSharedStats{}
+	}
+
 	// Don't calculate any diffs on the first run (but still update the state)
 	if len(prevState.StatementStats) == 0 || prevState.LastStatementStatsAt.IsZero() {
 		return newState, nil
@@ -61,6 +69,12 @@ func CollectAndDiff1minStats(ctx context.Context, c *postgres.Collection, connec
 		newState.QueuedServerIoStats[timeKey] = diffServerIoStats(newState.ServerIoStats, prevState.ServerIoStats)
 	}
 
+	newState.QueuedServerSharedStats = prevState.QueuedServerSharedStats
+	if newState.QueuedServerSharedStats == nil {
+		newState.QueuedServerSharedStats = make(state.HistoricPostgresServerSharedStatsMap)
+	}
+	newState.QueuedServerSharedStats[timeKey] = newState.ServerSharedStats.DiffSince(prevState.ServerSharedStats)
+
 	return newState, nil
 }
 
@@ -0,0 +1,64 @@
+package input
+
+import (
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// addLogQueryStats - Adds the per-query statistics derived from the Postgres
+// logs since the last full snapshot to the statement statistics
+func addLogQueryStats(server *state.Server, collectedAt time.Time, ts state.TransientState, logger *util.Logger) state.TransientState {
+	statementStats, statements, statementTexts, dropped := server.LogQueryStats.Drain(collectedAt, ts.Databases, ts.Roles)
+	if dropped > 0 {
+		logger.PrintWarning("Skipped %d calls in log-derived query statistics, since more than %d distinct queries were logged", dropped, state.LogQueryStatsMaxEntries)
+	}
+	if len(statementStats) == 0 {
+		return ts
+	}
+
+	if ts.StatementStats == nil {
+		ts.StatementStats = make(state.HistoricStatementStatsMap)
+	}
+	if ts.Statements == nil {
+		ts.Statements = make(state.PostgresStatementMap)
+	}
+	if ts.StatementTexts == nil {
+		ts.StatementTexts = make(state.PostgresStatementTextMap)
+	}
+	for timeKey, stats := range statementStats {
+		// pg_stat_statements data may have been collected at the same time, but
+		// for a different interval - merge into that bucket, since buckets are
+		// identified by their collection time
+		existing, ok := findStatementStatsBucket(ts.StatementStats, timeKey.CollectedAt)
+		if !ok {
+			ts.StatementStats[timeKey] = stats
+			continue
+		}
+		for key, value := range stats {
+			existing[key] = existing[key].Add(value)
+		}
+	}
+	for key, statement := range statements {
+		if _, ok := ts.Statements[key]; !ok {
+			ts.Statements[key] = statement
+		}
+	}
+	for fingerprint, text := range statementTexts {
+		if _, ok := ts.StatementTexts[fingerprint]; !ok {
+			ts.StatementTexts[fingerprint] = text
+		}
+	}
+
+	return ts
+}
+
+func findStatementStatsBucket(statementStats state.HistoricStatementStatsMap, collectedAt time.Time) (state.DiffedPostgresStatementStatsMap, bool) {
+	for timeKey, stats := range statementStats {
+		if timeKey.CollectedAt.Equal(collectedAt) {
+			return stats, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,62 @@
+package input
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestAddLogQueryStatsWithStatements(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	server := state.MakeServer(config.ServerConfig{EnableLogQueryStats: true, FilterQueryText: "unparsable"}, false)
+	server.LogQueryStats.AddLogLine(state.LogLine{
+		LogLevel:   pganalyze_collector.LogLineInformation_LOG,
+		BackendPid: 101,
+		Database:   "app",
+		Username:   "alice",
+		Content:    "duration: 2.000 ms  statement: SELECT 1\n",
+	})
+
+	fingerprint := util.FingerprintQuery("SELECT 1", "unparsable", -1)
+	logKey := state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: int64(fingerprint), Toplevel: true}
+	statementKey := state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: 42, Toplevel: true}
+
+	// Collected from pg_stat_statements for the last minute, at the same time as the full snapshot
+	collectedAt := time.Now()
+	statementsTimeKey := state.HistoricStatsTimeKey{CollectedAt: collectedAt, CollectedIntervalSecs: 60}
+	ts := state.TransientState{
+		Databases: []state.PostgresDatabase{{Oid: 1, Name: "app"}},
+		Roles:     []state.PostgresRole{{Oid: 10, Name: "alice"}},
+		StatementStats: state.HistoricStatementStatsMap{
+			statementsTimeKey: {
+				statementKey: {Calls: 5, TotalTime: 10},
+				logKey:       {Calls: 1, TotalTime: 1},
+			},
+		},
+	}
+
+	ts = addLogQueryStats(server, collectedAt, ts, logger)
+
+	if len(ts.StatementStats) != 1 {
+		t.Fatalf("want 1 time key; got %d: %v", len(ts.StatementStats), ts.StatementStats)
+	}
+	stats, ok := ts.StatementStats[statementsTimeKey]
+	if !ok {
+		t.Fatalf("want pg_stat_statements time key %v; got %v", statementsTimeKey, ts.StatementStats)
+	}
+	if got := stats[statementKey]; got.Calls != 5 || got.TotalTime != 10 {
+		t.Errorf("pg_stat_statements entry: want unchanged; got %+v", got)
+	}
+	if got := stats[logKey]; got.Calls != 2 || got.TotalTime != 3 {
+		t.Errorf("merged entry: want 2 calls, 3 ms; got %+v", got)
+	}
+	if _, ok := ts.Statements[logKey]; !ok {
+		t.Errorf("want statement for log-derived entry; got %v", ts.Statements)
+	}
+}
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Time allowed for checking and resetting pooled connections, independent of the
+// caller's context (which may already be canceled when the connection is returned)
+const connectionPoolCheckTimeout = 5 * time.Second
+
+// AcquireConnection - Returns a connection to the database from the server's
+// connection pool, establishing a new connection if none is available
+//
+// Connections must be returned with ReleaseConnection after use. If connection
+// pooling is disabled, this is equivalent to EstablishConnection.
+func AcquireConnection(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts, databaseName string) (*sql.DB, error) {
+	pool := server.ConnectionPool
+	if pool == nil {
+		return EstablishConnection(ctx, server, logger, opts, databaseName)
+	}
+
+	for {
+		conn := pool.Get(databaseName, time.Now())
+		if conn == nil {
+			break
+		}
+		err := checkPooledConnection(ctx, conn)
+		if err == nil {
+			return conn.DB, nil
+		}
+		if ctx.Err() != nil {
+			pool.Discard(conn.DB)
+			return nil, ctx.Err()
+		}
+		logger.PrintVerbose("Reconnecting to database \"%s\": %s", databaseName, err)
+		pool.Discard(conn.DB)
+	}
+
+	db, err := EstablishConnection(ctx, server, logger, opts, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	backendPid, inRecovery, err := getPooledConnectionSession(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	pool.Add(db, databaseName, backendPid, inRecovery, time.Now())
+
+	return db, nil
+}
+
+// ReleaseConnection - Returns a connection obtained from AcquireConnection
+//
+// Session settings changed by the caller (e.g. statement_timeout, or settings
+// for a query run) are reset before the connection can be reused. Connections
+// that can't be reset are closed instead.
+func ReleaseConnection(server *state.Server, logger *util.Logger, db *sql.DB) {
+	pool := server.ConnectionPool
+	if pool == nil {
+		db.Close()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectionPoolCheckTimeout)
+	defer cancel()
+
+	err := resetPooledConnection(ctx, server, logger, db)
+	if err != nil {
+		logger.PrintVerbose("Closing database connection that could not be reset: %s", err)
+		pool.Discard(db)
+		return
+	}
+	pool.Put(db, time.Now())
+}
+
+// SetupConnectionPoolForAllServers - Periodically closes idle pooled connections,
+// and closes all of them once the context is canceled (e.g. on reload)
+func SetupConnectionPoolForAllServers(ctx context.Context, servers []*state.Server) {
+	for idx := range servers {
+		pool := servers[idx].ConnectionPool
+		if pool == nil {
+			continue
+		}
+		go func(pool *state.ConnectionPool) {
+			ticker := time.NewTicker(15 * time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					pool.CloseAll()
+					return
+				case <-ticker.C:
+					pool.CloseIdle(time.Now())
+				}
+			}
+		}(pool)
+	}
+}
+
+// How often secret providers are asked to refresh credentials - this only
+// contacts the secret store once credentials are due to be refreshed
+const secretProviderRefreshInterval = 30 * time.Second
+
+// SetupSecretProviderForAllServers - Periodically refreshes database credentials
+// from secret providers (renewing their leases while pooled connections use them),
+// and closes pooled connections once the credentials were replaced
+//
+// Leases of replaced credentials are only revoked once the pooled connections
+// using them were returned, so that running queries aren't terminated.
+func SetupSecretProviderForAllServers(ctx context.Context, servers []*state.Server, logger *util.Logger) {
+	for idx := range servers {
+		server := servers[idx]
+		if server.SecretProvider == nil {
+			continue
+		}
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		go func(server *state.Server) {
+			ticker := time.NewTicker(secretProviderRefreshInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					err := server.SecretProvider.Refresh(ctx, func(replacedAt time.Time) bool {
+						prefixedLogger.PrintVerbose("Database credentials were replaced, closing pooled connections")
+						return server.ConnectionPool.CloseCreatedBefore(replacedAt)
+					})
+					if err != nil && ctx.Err() == nil {
+						prefixedLogger.PrintWarning("Could not refresh database credentials: %s", err)
+					}
+				}
+			}
+		}(server)
+	}
+}
+
+// Verifies the pooled connection still works, and still points to a server with
+// the same role - a changed recovery status means a failover happened without
+// the connection breaking (e.g. the old primary became a replica), and we need
+// to reconnect to follow the DNS entry to the new primary
+//
+// A changed backend PID means database/sql transparently replaced a broken
+// connection, which bypasses EstablishConnection (e.g. the statement_timeout
+// and max_collector_connections check), so we reconnect in that case as well.
+func checkPooledConnection(ctx context.Context, conn *state.PooledConnection) error {
+	ctx, cancel := context.WithTimeout(ctx, connectionPoolCheckTimeout)
+	defer cancel()
+
+	backendPid, inRecovery, err := getPooledConnectionSession(ctx, conn.DB)
+	if err != nil {
+		return err
+	}
+	if backendPid != conn.BackendPid {
+		return fmt.Errorf("connection was re-established")
+	}
+	if inRecovery != conn.InRecovery {
+		return fmt.Errorf("server recovery status changed")
+	}
+	return nil
+}
+
+func getPooledConnectionSession(ctx context.Context, db *sql.DB) (backendPid int32, inRecovery bool, err error) {
+	err = db.QueryRowContext(ctx, QueryMarkerSQL+"SELECT pg_catalog.pg_backend_pid(), pg_catalog.pg_is_in_recovery()").Scan(&backendPid, &inRecovery)
+	return
+}
+
+func resetPooledConnection(ctx context.Context, server *state.Server, logger *util.Logger, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, QueryMarkerSQL+"RESET ALL")
+	if err != nil {
+		return err
+	}
+	return SetDefaultStatementTimeout(ctx, db, logger, server)
+}
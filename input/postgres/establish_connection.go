@@ -3,27 +3,40 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/secrets"
 )
 
 func EstablishConnection(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts, databaseName string) (connection *sql.DB, err error) {
-	connection, err = connectToDb(ctx, server.Config, opts, databaseName)
+	serverConfig := server.Config
+	serverConfig.DbSslModePreferFailed = server.SslModePreferFailed.Load()
+	connection, err = connectToDb(ctx, serverConfig, server.SecretProvider, opts, databaseName)
 	if err != nil {
-		if err.Error() == "pq: SSL is not enabled on the server" && (server.Config.DbSslMode == "prefer" || server.Config.DbSslMode == "") {
-			server.Config.DbSslModePreferFailed = true
-			connection, err = connectToDb(ctx, server.Config, opts, databaseName)
+		if err.Error() == "pq: SSL is not enabled on the server" && (serverConfig.DbSslMode == "prefer" || serverConfig.DbSslMode == "") {
+			server.SslModePreferFailed.Store(true)
+			serverConfig.DbSslModePreferFailed = true
+			connection, err = connectToDb(ctx, serverConfig, server.SecretProvider, opts, databaseName)
 		}
 	}
+	if err != nil && server.SecretProvider != nil && isAuthenticationError(err) {
+		// The credentials may have been rotated since they were last read, retry
+		// once with credentials freshly read from the secret provider
+		logger.PrintVerbose("Authentication failed, reading database credentials from secret provider again: %s", err)
+		server.SecretProvider.Invalidate()
+		connection, err = connectToDb(ctx, serverConfig, server.SecretProvider, opts, databaseName)
+	}
 
 	if err != nil {
 		return
 	}
 
-	err = validateConnectionCount(ctx, connection, logger, server.Config.MaxCollectorConnections, opts)
+	err = validateConnectionCount(ctx, connection, logger, server.Config.MaxCollectorConnections, server.ConnectionPool, opts)
 	if err != nil {
 		connection.Close()
 		return
@@ -38,20 +51,29 @@ func EstablishConnection(ctx context.Context, server *state.Server, logger *util
 	return
 }
 
-func connectToDb(ctx context.Context, config config.ServerConfig, opts state.CollectionOpts, databaseName string) (*sql.DB, error) {
+func connectToDb(ctx context.Context, config config.ServerConfig, secretProvider secrets.Provider, opts state.CollectionOpts, databaseName string) (*sql.DB, error) {
 	var db *sql.DB
 	var iamParams iamConnectionParams
 	var err error
 
 	driverName := "postgres"
+	passwordOverride := ""
 	if config.DbUseIamAuth {
 		driverName, iamParams, err = getIamConnectionParams(ctx, config)
 		if err != nil {
 			return nil, err
 		}
+		passwordOverride = iamParams.passwordOverride
+	} else if secretProvider != nil {
+		creds, err := secretProvider.Credentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		config.DbUsername = creds.Username
+		passwordOverride = creds.Password
 	}
 
-	connectString, err := config.GetPqOpenString(databaseName, iamParams.passwordOverride, iamParams.hostOverride, iamParams.sslmodeOverride)
+	connectString, err := config.GetPqOpenString(databaseName, passwordOverride, iamParams.hostOverride, iamParams.sslmodeOverride)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +95,13 @@ func connectToDb(ctx context.Context, config config.ServerConfig, opts state.Col
 	return db, nil
 }
 
-func validateConnectionCount(ctx context.Context, connection *sql.DB, logger *util.Logger, maxCollectorConnections int, opts state.CollectionOpts) error {
+// Whether the connection failed due to invalid credentials
+func isAuthenticationError(err error) bool {
+	var e *pq.Error
+	return errors.As(err, &e) && (e.Code == "28P01" || e.Code == "28000") // invalid_password, invalid_authorization_specification
+}
+
+func validateConnectionCount(ctx context.Context, connection *sql.DB, logger *util.Logger, maxCollectorConnections int, pool *state.ConnectionPool, opts state.CollectionOpts) error {
 	var connectionCount int
 
 	err := connection.QueryRowContext(ctx, QueryMarkerSQL+"SELECT pg_catalog.count(*) FROM pg_catalog.pg_stat_activity WHERE application_name = '"+opts.CollectorApplicationName+"'").Scan(&connectionCount)
@@ -81,6 +109,16 @@ func validateConnectionCount(ctx context.Context, connection *sql.DB, logger *ut
 		return err
 	}
 
+	// Idle pooled connections count towards the limit as well, close them first to
+	// make room (e.g. when opening connections for parallel schema collection)
+	if connectionCount > maxCollectorConnections {
+		closed := pool.CloseLeastRecentlyUsedIdle(connectionCount - maxCollectorConnections)
+		if closed > 0 {
+			logger.PrintVerbose("Closed %d idle pooled connection(s) to stay within max_collector_connections", closed)
+		}
+		connectionCount -= closed
+	}
+
 	if connectionCount > maxCollectorConnections {
 		return fmt.Errorf("Too many open monitoring connections (current: %d, maximum allowed: %d), exiting", connectionCount, maxCollectorConnections)
 	}
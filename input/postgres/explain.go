@@ -49,12 +49,12 @@ func RunExplain(ctx context.Context, server *state.Server, inputs []state.Postgr
 }
 
 func runExplainForDb(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, dbName string, dbSamples []state.PostgresQuerySample) (outputs []state.PostgresQuerySample) {
-	db, err := EstablishConnection(ctx, server, logger, collectionOpts, dbName)
+	db, err := AcquireConnection(ctx, server, logger, collectionOpts, dbName)
 	if err != nil {
 		logger.PrintVerbose("Could not connect to %s to run explain: %s; skipping", dbName, err)
 		return nil
 	}
-	defer db.Close()
+	defer ReleaseConnection(server, logger, db)
 
 	c, err := NewCollection(ctx, logger, server, collectionOpts, db)
 	if err != nil {
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// Maximum number of pg_locks rows collected per activity snapshot, to bound the
+// snapshot size during large lock pileups (awaited locks are collected first)
+const lockRowLimit = 1000
+
+// Only collects locks involved in a lock wait: locks that are awaited, and
+// granted locks on the same lock target held by one of the blocking backends.
+// Relation names are only resolved for the current database.
+const locksSQL string = `
+WITH waiting AS (
+	SELECT l.*, pg_catalog.pg_blocking_pids(l.pid) AS blocking_pids
+	  FROM pg_catalog.pg_locks l
+	 WHERE NOT l.granted AND l.pid IS NOT NULL
+)
+SELECT l.pid, l.locktype, d.datname, l.relation, n.nspname,
+	   CASE WHEN $1 = '' OR (n.nspname || '.' || c.relname) !~* $1 THEN c.relname END,
+	   l.page, l.tuple, l.virtualxid, l.transactionid::text::bigint, l.mode, l.granted, l.fastpath
+  FROM pg_catalog.pg_locks l
+	   LEFT JOIN pg_catalog.pg_database d ON (d.oid = l.database)
+	   LEFT JOIN pg_catalog.pg_class c ON (d.datname = pg_catalog.current_database() AND c.oid = l.relation)
+	   LEFT JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ WHERE l.pid IS NOT NULL
+	   AND (NOT l.granted OR EXISTS (
+		   SELECT 1
+			 FROM waiting w
+			WHERE l.pid = ANY(w.blocking_pids)
+				  AND w.locktype = l.locktype
+				  AND w.database IS NOT DISTINCT FROM l.database
+				  AND w.relation IS NOT DISTINCT FROM l.relation
+				  AND w.page IS NOT DISTINCT FROM l.page
+				  AND w.tuple IS NOT DISTINCT FROM l.tuple
+				  AND w.virtualxid IS NOT DISTINCT FROM l.virtualxid
+				  AND w.transactionid IS NOT DISTINCT FROM l.transactionid
+				  AND w.classid IS NOT DISTINCT FROM l.classid
+				  AND w.objid IS NOT DISTINCT FROM l.objid
+				  AND w.objsubid IS NOT DISTINCT FROM l.objsubid
+	   ))
+ ORDER BY l.granted, l.pid
+ LIMIT %d`
+
+// GetLocks - Collects the locks involved in current lock waits
+func GetLocks(ctx context.Context, c *Collection, db *sql.DB) ([]state.PostgresLock, error) {
+	rows, err := db.QueryContext(ctx, QueryMarkerSQL+fmt.Sprintf(locksSQL, lockRowLimit), c.Config.IgnoreSchemaRegexp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locks []state.PostgresLock
+
+	for rows.Next() {
+		var row state.PostgresLock
+
+		err := rows.Scan(&row.Pid, &row.LockType, &row.DatabaseName, &row.RelationOid,
+			&row.SchemaName, &row.RelationName, &row.Page, &row.Tuple, &row.VirtualXid,
+			&row.TransactionId, &row.Mode, &row.Granted, &row.Fastpath)
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(locks) == lockRowLimit {
+		c.Logger.PrintVerbose("Lock information truncated to %d locks", lockRowLimit)
+	}
+
+	return locks, nil
+}
+
+// GetLockWaits - Determines the blocking chains for all backends waiting on a
+// lock, based on the blocking PIDs reported for each backend
+func GetLockWaits(backends []state.PostgresBackend) []state.PostgresLockWait {
+	blockedBy := make(map[int32][]int32)
+	for _, backend := range backends {
+		if len(backend.BlockedByPids) > 0 {
+			blockedBy[backend.Pid] = backend.BlockedByPids
+		}
+	}
+
+	var lockWaits []state.PostgresLockWait
+	chains := make(map[int32]*blockingChain)
+	onPath := make(map[int32]bool)
+	for _, backend := range backends {
+		if len(backend.BlockedByPids) == 0 {
+			continue
+		}
+		chain := followBlockingChain(blockedBy, backend.Pid, chains, onPath)
+		lockWait := state.PostgresLockWait{
+			Pid:           backend.Pid,
+			BlockedByPids: backend.BlockedByPids,
+			ChainDepth:    chain.depth,
+			Deadlock:      chain.cycle,
+		}
+		for pid := range chain.roots {
+			lockWait.RootBlockerPids = append(lockWait.RootBlockerPids, pid)
+		}
+		sort.Slice(lockWait.RootBlockerPids, func(i, j int) bool { return lockWait.RootBlockerPids[i] < lockWait.RootBlockerPids[j] })
+		lockWaits = append(lockWaits, lockWait)
+	}
+
+	return lockWaits
+}
+
+type blockingChain struct {
+	depth int32
+	roots map[int32]bool
+	cycle bool
+}
+
+// Walks the blocking PIDs of the given backend depth-first, collecting the root
+// blockers, the longest chain length and whether a cycle was found
+//
+// Results are remembered for each backend, since in a lock queue each waiter is
+// typically blocked by all waiters ahead of it.
+func followBlockingChain(blockedBy map[int32][]int32, pid int32, chains map[int32]*blockingChain, onPath map[int32]bool) *blockingChain {
+	if chain, ok := chains[pid]; ok {
+		return chain
+	}
+	chain := &blockingChain{roots: make(map[int32]bool)}
+	onPath[pid] = true
+	for _, blockerPid := range blockedBy[pid] {
+		if onPath[blockerPid] {
+			chain.cycle = true
+			continue
+		}
+		if _, blocked := blockedBy[blockerPid]; !blocked {
+			chain.roots[blockerPid] = true
+			chain.depth = max(chain.depth, 1)
+			continue
+		}
+		blockerChain := followBlockingChain(blockedBy, blockerPid, chains, onPath)
+		for rootPid := range blockerChain.roots {
+			chain.roots[rootPid] = true
+		}
+		chain.depth = max(chain.depth, blockerChain.depth+1)
+		chain.cycle = chain.cycle || blockerChain.cycle
+	}
+	delete(onPath, pid)
+	chains[pid] = chain
+	return chain
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/state"
+)
+
+var lockWaitTests = []struct {
+	name     string
+	backends []state.PostgresBackend
+	expected []state.PostgresLockWait
+}{
+	{
+		"no waits",
+		[]state.PostgresBackend{{Pid: 1}, {Pid: 2}},
+		nil,
+	},
+	{
+		// 2 waits on 1, 3 waits on both (hard blocked by 1, soft blocked by 2 queued ahead)
+		"lock queue",
+		[]state.PostgresBackend{{Pid: 1}, {Pid: 2, BlockedByPids: []int32{1}}, {Pid: 3, BlockedByPids: []int32{1, 2}}},
+		[]state.PostgresLockWait{
+			{Pid: 2, BlockedByPids: []int32{1}, RootBlockerPids: []int32{1}, ChainDepth: 1},
+			{Pid: 3, BlockedByPids: []int32{1, 2}, RootBlockerPids: []int32{1}, ChainDepth: 2},
+		},
+	},
+	{
+		"multiple roots",
+		[]state.PostgresBackend{{Pid: 1}, {Pid: 2}, {Pid: 3, BlockedByPids: []int32{2}}, {Pid: 4, BlockedByPids: []int32{3, 1}}},
+		[]state.PostgresLockWait{
+			{Pid: 3, BlockedByPids: []int32{2}, RootBlockerPids: []int32{2}, ChainDepth: 1},
+			{Pid: 4, BlockedByPids: []int32{3, 1}, RootBlockerPids: []int32{1, 2}, ChainDepth: 2},
+		},
+	},
+	{
+		// 5 is blocked by a deadlock between 1 and 2 that has not been detected yet
+		"deadlock",
+		[]state.PostgresBackend{{Pid: 1, BlockedByPids: []int32{2}}, {Pid: 2, BlockedByPids: []int32{1}}, {Pid: 5, BlockedByPids: []int32{1}}},
+		[]state.PostgresLockWait{
+			{Pid: 1, BlockedByPids: []int32{2}, ChainDepth: 1, Deadlock: true},
+			{Pid: 2, BlockedByPids: []int32{1}, ChainDepth: 0, Deadlock: true},
+			{Pid: 5, BlockedByPids: []int32{1}, ChainDepth: 2, Deadlock: true},
+		},
+	},
+}
+
+func TestGetLockWaits(t *testing.T) {
+	for _, test := range lockWaitTests {
+		actual := GetLockWaits(test.backends)
+		if diff := pretty.Compare(test.expected, actual); diff != "" {
+			t.Errorf("%s: unexpected lock waits (-want +got):\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestGetLockWaitsLargeQueue(t *testing.T) {
+	// Each waiter in a lock queue is blocked by all waiters ahead of it, which
+	// must not require walking every possible path
+	var backends []state.PostgresBackend
+	backends = append(backends, state.PostgresBackend{Pid: 1})
+	for pid := int32(2); pid <= 500; pid++ {
+		var blockedBy []int32
+		for blocker := int32(1); blocker < pid; blocker++ {
+			blockedBy = append(blockedBy, blocker)
+		}
+		backends = append(backends, state.PostgresBackend{Pid: pid, BlockedByPids: blockedBy})
+	}
+
+	lockWaits := GetLockWaits(backends)
+	if len(lockWaits) != 499 {
+		t.Fatalf("want 499 lock waits; got %d", len(lockWaits))
+	}
+	last := lockWaits[len(lockWaits)-1]
+	if last.ChainDepth != 499 || len(last.RootBlockerPids) != 1 || last.RootBlockerPids[0] != 1 {
+		t.Errorf("unexpected lock wait for last waiter: depth %d, root blockers %v", last.ChainDepth, last.RootBlockerPids)
+	}
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	pg_query "github.com/pganalyze/pg_query_go/v6"
+)
+
+// MaintenanceCommand - A maintenance command that was validated to be safe to run
+type MaintenanceCommand struct {
+	// Name of the index created by a CREATE INDEX CONCURRENTLY command, used to
+	// clean up the invalid index that remains if the command fails
+	IndexSchema string
+	IndexName   string
+
+	// Tables of an ANALYZE command, which is run through the pganalyze.analyze_table
+	// helper for each table (options like VERBOSE are not passed on)
+	AnalyzeTables []AnalyzeTable
+}
+
+// AnalyzeTable - Table (and optionally its columns) that should be analyzed
+type AnalyzeTable struct {
+	Name    string // Quoted, and schema-qualified if the command specified a schema
+	Columns []string
+}
+
+// ValidateMaintenanceCommand - Checks that the query is a single maintenance command
+// of the expected type, that targets specific relations and does not block writes
+func ValidateMaintenanceCommand(queryType pganalyze_collector.QueryRunType, query string) (MaintenanceCommand, error) {
+	var cmd MaintenanceCommand
+
+	parseResult, err := pg_query.Parse(query)
+	if err != nil {
+		return cmd, fmt.Errorf("query is not permitted to run - failed to parse")
+	}
+	if len(parseResult.Stmts) != 1 {
+		return cmd, fmt.Errorf("query is not permitted to run - multi-statement query string")
+	}
+
+	stmt := parseResult.Stmts[0].Stmt
+	switch queryType {
+	case pganalyze_collector.QueryRunType_ANALYZE:
+		analyzeStmt := stmt.GetVacuumStmt()
+		if analyzeStmt == nil || analyzeStmt.IsVacuumcmd {
+			return cmd, fmt.Errorf("query is not permitted to run - not an ANALYZE statement")
+		}
+		if len(analyzeStmt.Rels) == 0 {
+			return cmd, fmt.Errorf("query is not permitted to run - ANALYZE must specify a table")
+		}
+		for _, rel := range analyzeStmt.Rels {
+			vacuumRel := rel.GetVacuumRelation()
+			if vacuumRel == nil || vacuumRel.Relation == nil {
+				return cmd, fmt.Errorf("query is not permitted to run - ANALYZE must specify a table")
+			}
+			table := AnalyzeTable{Name: pq.QuoteIdentifier(vacuumRel.Relation.Relname)}
+			if vacuumRel.Relation.Schemaname != "" {
+				table.Name = pq.QuoteIdentifier(vacuumRel.Relation.Schemaname) + "." + table.Name
+			}
+			for _, col := range vacuumRel.VaCols {
+				table.Columns = append(table.Columns, col.GetString_().GetSval())
+			}
+			cmd.AnalyzeTables = append(cmd.AnalyzeTables, table)
+		}
+	case pganalyze_collector.QueryRunType_REINDEX:
+		reindexStmt := stmt.GetReindexStmt()
+		if reindexStmt == nil {
+			return cmd, fmt.Errorf("query is not permitted to run - not a REINDEX statement")
+		}
+		if reindexStmt.Kind != pg_query.ReindexObjectType_REINDEX_OBJECT_INDEX && reindexStmt.Kind != pg_query.ReindexObjectType_REINDEX_OBJECT_TABLE {
+			return cmd, fmt.Errorf("query is not permitted to run - REINDEX must target an index or table")
+		}
+		if !isDefElemEnabled(reindexStmt.Params, "concurrently") {
+			return cmd, fmt.Errorf("query is not permitted to run - REINDEX must use CONCURRENTLY")
+		}
+	case pganalyze_collector.QueryRunType_CREATE_INDEX:
+		indexStmt := stmt.GetIndexStmt()
+		if indexStmt == nil {
+			return cmd, fmt.Errorf("query is not permitted to run - not a CREATE INDEX statement")
+		}
+		if !indexStmt.Concurrent {
+			return cmd, fmt.Errorf("query is not permitted to run - CREATE INDEX must use CONCURRENTLY")
+		}
+		if indexStmt.Idxname == "" {
+			return cmd, fmt.Errorf("query is not permitted to run - CREATE INDEX must specify an index name")
+		}
+		cmd.IndexSchema = indexStmt.Relation.Schemaname
+		cmd.IndexName = indexStmt.Idxname
+	case pganalyze_collector.QueryRunType_DROP_INDEX:
+		dropStmt := stmt.GetDropStmt()
+		if dropStmt == nil || dropStmt.RemoveType != pg_query.ObjectType_OBJECT_INDEX {
+			return cmd, fmt.Errorf("query is not permitted to run - not a DROP INDEX statement")
+		}
+		if !dropStmt.Concurrent {
+			return cmd, fmt.Errorf("query is not permitted to run - DROP INDEX must use CONCURRENTLY")
+		}
+	default:
+		return cmd, fmt.Errorf("query is not permitted to run - not a maintenance command")
+	}
+
+	// Index expressions and predicates could otherwise call these functions
+	err = validateBlockedFunctions(parseResult)
+	if err != nil {
+		return cmd, err
+	}
+
+	return cmd, nil
+}
+
+// Checks that the given boolean option is present, and that each occurrence
+// of it is true (the last one takes effect in Postgres)
+func isDefElemEnabled(params []*pg_query.Node, name string) bool {
+	found := false
+	for _, param := range params {
+		if defElem := param.GetDefElem(); defElem != nil && defElem.Defname == name {
+			if !defElemBoolean(defElem) {
+				return false
+			}
+			found = true
+		}
+	}
+	return found
+}
+
+// Interprets the argument of a boolean option the same way as Postgres'
+// defGetBoolean - values that Postgres would reject are treated as false
+func defElemBoolean(defElem *pg_query.DefElem) bool {
+	if defElem.Arg == nil {
+		return true
+	}
+	switch arg := defElem.Arg.Node.(type) {
+	case *pg_query.Node_Integer:
+		return arg.Integer.Ival == 1
+	case *pg_query.Node_String_:
+		switch strings.ToLower(arg.String_.Sval) {
+		case "true", "on":
+			return true
+		}
+	}
+	return false
+}
+
+const createIndexProgressSQL string = `
+SELECT phase, blocks_total, blocks_done, tuples_total, tuples_done,
+	   lockers_total, lockers_done, partitions_total, partitions_done
+  FROM pg_catalog.pg_stat_progress_create_index
+ WHERE pid = $1`
+
+// GetCreateIndexProgress - Returns the progress of the CREATE INDEX or REINDEX
+// command running in the given backend, or nil if there is none
+func GetCreateIndexProgress(ctx context.Context, db *sql.DB, pid int) (*state.QueryRunProgress, error) {
+	var p state.QueryRunProgress
+	err := db.QueryRowContext(ctx, QueryMarkerSQL+createIndexProgressSQL, pid).Scan(
+		&p.Phase, &p.BlocksTotal, &p.BlocksDone, &p.TuplesTotal, &p.TuplesDone,
+		&p.LockersTotal, &p.LockersDone, &p.PartitionsTotal, &p.PartitionsDone,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+const invalidIndexSQL string = `
+SELECT pg_catalog.quote_ident(n.nspname) || '.' || pg_catalog.quote_ident(c.relname)
+  FROM pg_catalog.pg_index i
+	   JOIN pg_catalog.pg_class c ON (c.oid = i.indexrelid)
+	   JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ WHERE c.oid = pg_catalog.to_regclass($1) AND NOT i.indisvalid`
+
+// DropInvalidIndex - Drops the index created by a failed CREATE INDEX CONCURRENTLY
+// command, if it was left behind in an invalid state
+//
+// Returns the name of the dropped index, or an empty string if there was none.
+func DropInvalidIndex(ctx context.Context, db *sql.Conn, cmd MaintenanceCommand) (string, error) {
+	name := pq.QuoteIdentifier(cmd.IndexName)
+	if cmd.IndexSchema != "" {
+		name = pq.QuoteIdentifier(cmd.IndexSchema) + "." + name
+	}
+
+	var qualifiedName string
+	err := db.QueryRowContext(ctx, QueryMarkerSQL+invalidIndexSQL, name).Scan(&qualifiedName)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	_, err = db.ExecContext(ctx, QueryMarkerSQL+"DROP INDEX CONCURRENTLY "+qualifiedName)
+	if err != nil {
+		return "", err
+	}
+	return qualifiedName, nil
+}
@@ -0,0 +1,78 @@
+package postgres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+)
+
+var maintenanceCommandTests = []struct {
+	queryType     pganalyze_collector.QueryRunType
+	query         string
+	expectedIndex string
+	expectedError string
+}{
+	{pganalyze_collector.QueryRunType_ANALYZE, "ANALYZE public.test", "", ""},
+	{pganalyze_collector.QueryRunType_ANALYZE, "ANALYZE (VERBOSE) test (id, name)", "", ""},
+	{pganalyze_collector.QueryRunType_ANALYZE, "ANALYZE", "", "query is not permitted to run - ANALYZE must specify a table"},
+	{pganalyze_collector.QueryRunType_ANALYZE, "VACUUM test", "", "query is not permitted to run - not an ANALYZE statement"},
+	{pganalyze_collector.QueryRunType_ANALYZE, "ANALYZE test; DROP TABLE test", "", "query is not permitted to run - multi-statement query string"},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX INDEX CONCURRENTLY test_pkey", "", ""},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY) TABLE public.test", "", ""},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY true) INDEX test_pkey", "", ""},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY 1) INDEX test_pkey", "", ""},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX INDEX test_pkey", "", "query is not permitted to run - REINDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY false) INDEX test_pkey", "", "query is not permitted to run - REINDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY off) INDEX test_pkey", "", "query is not permitted to run - REINDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY 0) INDEX test_pkey", "", "query is not permitted to run - REINDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX (CONCURRENTLY, CONCURRENTLY false) INDEX test_pkey", "", "query is not permitted to run - REINDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_REINDEX, "REINDEX DATABASE CONCURRENTLY postgres", "", "query is not permitted to run - REINDEX must target an index or table"},
+	{pganalyze_collector.QueryRunType_CREATE_INDEX, "CREATE INDEX CONCURRENTLY test_name_idx ON public.test (name)", "public.test_name_idx", ""},
+	{pganalyze_collector.QueryRunType_CREATE_INDEX, "CREATE INDEX CONCURRENTLY ON test (name)", "", "query is not permitted to run - CREATE INDEX must specify an index name"},
+	{pganalyze_collector.QueryRunType_CREATE_INDEX, "CREATE INDEX test_name_idx ON test (name)", "", "query is not permitted to run - CREATE INDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_CREATE_INDEX, "CREATE INDEX CONCURRENTLY test_idx ON test ((dblink('host=example.com', 'SELECT 1')))", "", "query is not permitted to run - function not allowed: dblink"},
+	{pganalyze_collector.QueryRunType_CREATE_INDEX, "DROP INDEX CONCURRENTLY test_name_idx", "", "query is not permitted to run - not a CREATE INDEX statement"},
+	{pganalyze_collector.QueryRunType_DROP_INDEX, "DROP INDEX CONCURRENTLY IF EXISTS test_name_idx", "", ""},
+	{pganalyze_collector.QueryRunType_DROP_INDEX, "DROP INDEX test_name_idx", "", "query is not permitted to run - DROP INDEX must use CONCURRENTLY"},
+	{pganalyze_collector.QueryRunType_DROP_INDEX, "DROP TABLE test", "", "query is not permitted to run - not a DROP INDEX statement"},
+	{pganalyze_collector.QueryRunType_EXPLAIN, "ANALYZE test", "", "query is not permitted to run - not a maintenance command"},
+}
+
+func TestValidateMaintenanceCommand(t *testing.T) {
+	for _, test := range maintenanceCommandTests {
+		cmd, err := postgres.ValidateMaintenanceCommand(test.queryType, test.query)
+		if test.expectedError != "" {
+			if err == nil || err.Error() != test.expectedError {
+				t.Errorf("%s: want error %q; got %v", test.query, test.expectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: want no error; got %s", test.query, err)
+			continue
+		}
+		index := cmd.IndexName
+		if cmd.IndexSchema != "" {
+			index = cmd.IndexSchema + "." + index
+		}
+		if index != test.expectedIndex {
+			t.Errorf("%s: want index %q; got %q", test.query, test.expectedIndex, index)
+		}
+	}
+}
+
+func TestValidateMaintenanceCommandAnalyzeTables(t *testing.T) {
+	cmd, err := postgres.ValidateMaintenanceCommand(pganalyze_collector.QueryRunType_ANALYZE, "ANALYZE (VERBOSE) public.test, \"Other\" (id, name)")
+	if err != nil {
+		t.Fatalf("want no error; got %s", err)
+	}
+	expected := []postgres.AnalyzeTable{
+		{Name: "\"public\".\"test\""},
+		{Name: "\"Other\"", Columns: []string{"id", "name"}},
+	}
+	if !reflect.DeepEqual(cmd.AnalyzeTables, expected) {
+		t.Errorf("want %v; got %v", expected, cmd.AnalyzeTables)
+	}
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Only matches the backend while it is actively running the query run, so we
+// don't signal a (pooled) connection that has already moved on to other work
+const signalQueryRunSQL string = `
+SELECT %s(pid)
+  FROM pg_catalog.pg_stat_activity
+ WHERE pid = $1 AND state = 'active' AND query LIKE $2`
+
+// SignalQueryRunBackend - Cancels (or terminates) the backend running the given query run
+//
+// Returns whether the backend was found running the query run.
+func SignalQueryRunBackend(ctx context.Context, db *sql.DB, pid int, queryRunId int64, terminate bool) (bool, error) {
+	function := "pg_catalog.pg_cancel_backend"
+	if terminate {
+		function = "pg_catalog.pg_terminate_backend"
+	}
+	marker := fmt.Sprintf("%%pganalyze-query-run:%d */%%", queryRunId)
+
+	var signalled bool
+	err := db.QueryRowContext(ctx, QueryMarkerSQL+fmt.Sprintf(signalQueryRunSQL, function), pid, marker).Scan(&signalled)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return signalled, nil
+}
@@ -56,6 +56,62 @@ SELECT client_addr,
  WHERE client_addr IS NOT NULL
  AND NOT (client_addr = '127.0.0.1' AND application_name = 'wal_uploader')`
 
+const replicationSlotsSQL string = `
+SELECT s.slot_name,
+			 s.plugin,
+			 s.slot_type,
+			 COALESCE(s.datoid, 0),
+			 s.temporary,
+			 s.active,
+			 s.active_pid,
+			 s.restart_lsn,
+			 s.confirmed_flush_lsn,
+			 pg_catalog.pg_wal_lsn_diff(l.current_lsn, s.restart_lsn)::bigint AS restart_lsn_byte_lag,
+			 pg_catalog.pg_wal_lsn_diff(l.current_lsn, s.confirmed_flush_lsn)::bigint AS confirmed_flush_lsn_byte_lag,
+			 %s,
+			 %s
+	FROM pg_catalog.pg_replication_slots s
+			 CROSS JOIN (
+				 SELECT CASE WHEN pg_catalog.pg_is_in_recovery() THEN pg_catalog.pg_last_wal_replay_lsn() ELSE pg_catalog.pg_current_wal_lsn() END AS current_lsn
+			 ) l
+			 %s
+ ORDER BY s.slot_name`
+
+const replicationSlotsWalStatusFieldsPg13 string = "s.wal_status, s.safe_wal_size"
+const replicationSlotsWalStatusFieldsDefault string = "NULL, NULL"
+
+const replicationSlotsStatsFieldsPg14 string = `COALESCE(st.spill_txns, 0), COALESCE(st.spill_count, 0), COALESCE(st.spill_bytes, 0),
+			 COALESCE(st.stream_txns, 0), COALESCE(st.stream_count, 0), COALESCE(st.stream_bytes, 0),
+			 COALESCE(st.total_txns, 0), COALESCE(st.total_bytes, 0), st.stats_reset`
+const replicationSlotsStatsFieldsDefault string = "0, 0, 0, 0, 0, 0, 0, 0, NULL"
+const replicationSlotsStatsJoinPg14 string = "LEFT JOIN pg_catalog.pg_stat_replication_slots st ON (st.slot_name = s.slot_name)"
+
+// The apply worker is the one without a relation (table synchronization workers have one),
+// and in Postgres 16+ without a leader (parallel apply workers have one)
+const subscriptionsSQL string = `
+SELECT s.oid,
+			 s.subname,
+			 s.subdbid,
+			 s.subenabled,
+			 w.pid,
+			 w.received_lsn,
+			 w.latest_end_lsn,
+			 w.last_msg_send_time,
+			 w.last_msg_receipt_time,
+			 w.latest_end_time,
+			 (SELECT pg_catalog.count(*) FROM pg_catalog.pg_stat_subscription t WHERE t.subid = s.oid AND t.relid IS NOT NULL)::int AS sync_workers,
+			 %s
+	FROM pg_catalog.pg_subscription s
+			 LEFT JOIN pg_catalog.pg_stat_subscription w ON (w.subid = s.oid AND w.relid IS NULL%s)
+			 %s
+ ORDER BY s.subname`
+
+const subscriptionsApplyWorkerFilterPg16 string = " AND w.leader_pid IS NULL"
+
+const subscriptionsStatsFieldsPg15 string = "COALESCE(ss.apply_error_count, 0), COALESCE(ss.sync_error_count, 0), ss.stats_reset"
+const subscriptionsStatsFieldsDefault string = "0, 0, NULL"
+const subscriptionsStatsJoinPg15 string = "LEFT JOIN pg_catalog.pg_stat_subscription_stats ss ON (ss.subid = s.oid)"
+
 func GetReplication(ctx context.Context, c *Collection, db *sql.DB) (state.PostgresReplication, error) {
 	var err error
 	var repl state.PostgresReplication
@@ -127,9 +183,179 @@ func GetReplication(ctx context.Context, c *Collection, db *sql.DB) (state.Postg
 		return repl, err
 	}
 
+	repl.ReplicationSlots, err = getReplicationSlots(ctx, c, db)
+	if err != nil {
+		return repl, err
+	}
+
+	repl.Subscriptions, err = getSubscriptions(ctx, c, db)
+	if err != nil {
+		return repl, err
+	}
+
 	return repl, nil
 }
 
+func getReplicationSlots(ctx context.Context, c *Collection, db *sql.DB) ([]state.PostgresReplicationSlot, error) {
+	walStatusFields := replicationSlotsWalStatusFieldsDefault
+	if c.PostgresVersion.Numeric >= state.PostgresVersion13 {
+		walStatusFields = replicationSlotsWalStatusFieldsPg13
+	}
+	statsFields := replicationSlotsStatsFieldsDefault
+	statsJoin := ""
+	if c.PostgresVersion.Numeric >= state.PostgresVersion14 {
+		statsFields = replicationSlotsStatsFieldsPg14
+		statsJoin = replicationSlotsStatsJoinPg14
+	}
+
+	rows, err := db.QueryContext(ctx, QueryMarkerSQL+fmt.Sprintf(replicationSlotsSQL, walStatusFields, statsFields, statsJoin))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var slots []state.PostgresReplicationSlot
+	for rows.Next() {
+		var s state.PostgresReplicationSlot
+
+		err := rows.Scan(&s.SlotName, &s.Plugin, &s.SlotType, &s.DatabaseOid, &s.Temporary,
+			&s.Active, &s.ActivePid, &s.RestartLsn, &s.ConfirmedFlushLsn,
+			&s.RestartLsnByteLag, &s.ConfirmedFlushLsnByteLag, &s.WalStatus, &s.SafeWalSize,
+			&s.SpillTxns, &s.SpillCount, &s.SpillBytes, &s.StreamTxns, &s.StreamCount,
+			&s.StreamBytes, &s.TotalTxns, &s.TotalBytes, &s.StatsReset)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
+
+func getSubscriptions(ctx context.Context, c *Collection, db *sql.DB) ([]state.PostgresSubscription, error) {
+	applyWorkerFilter := ""
+	if c.PostgresVersion.Numeric >= state.PostgresVersion16 {
+		applyWorkerFilter = subscriptionsApplyWorkerFilterPg16
+	}
+	statsFields := subscriptionsStatsFieldsDefault
+	statsJoin := ""
+	if c.PostgresVersion.Numeric >= state.PostgresVersion15 {
+		statsFields = subscriptionsStatsFieldsPg15
+		statsJoin = subscriptionsStatsJoinPg15
+	}
+
+	rows, err := db.QueryContext(ctx, QueryMarkerSQL+fmt.Sprintf(subscriptionsSQL, statsFields, applyWorkerFilter, statsJoin))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []state.PostgresSubscription
+	for rows.Next() {
+		var s state.PostgresSubscription
+
+		err := rows.Scan(&s.Oid, &s.Name, &s.DatabaseOid, &s.Enabled, &s.Pid,
+			&s.ReceivedLsn, &s.LatestEndLsn, &s.LastMsgSendTime, &s.LastMsgReceiptTime,
+			&s.LatestEndTime, &s.SyncWorkers, &s.ApplyErrorCount, &s.SyncErrorCount, &s.StatsReset)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+const publicationsSQL string = `
+SELECT pubname, puballtables, pubinsert, pubupdate, pubdelete, %s, %s
+	FROM pg_catalog.pg_publication
+ ORDER BY pubname`
+
+const publicationTablesSQL string = `
+SELECT pt.pubname, pt.schemaname, pt.tablename
+	FROM pg_catalog.pg_publication_tables pt
+			 JOIN pg_catalog.pg_publication p ON (p.pubname = pt.pubname)
+ WHERE NOT p.puballtables
+			 AND ($1 = '' OR (pt.schemaname || '.' || pt.tablename) !~* $1)
+ ORDER BY pt.pubname, pt.schemaname, pt.tablename`
+
+// GetPublications - Collects the logical replication publications in the current
+// database, and the tables that are part of each publication
+func GetPublications(ctx context.Context, c *Collection, db *sql.DB, currentDatabaseOid state.Oid) ([]state.PostgresPublication, error) {
+	truncateField := "false"
+	if c.PostgresVersion.Numeric >= state.PostgresVersion11 {
+		truncateField = "pubtruncate"
+	}
+	viaRootField := "false"
+	if c.PostgresVersion.Numeric >= state.PostgresVersion13 {
+		viaRootField = "pubviaroot"
+	}
+
+	rows, err := db.QueryContext(ctx, QueryMarkerSQL+fmt.Sprintf(publicationsSQL, truncateField, viaRootField))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publications []state.PostgresPublication
+	pubIdx := make(map[string]int)
+	for rows.Next() {
+		p := state.PostgresPublication{DatabaseOid: currentDatabaseOid}
+
+		err := rows.Scan(&p.Name, &p.AllTables, &p.Insert, &p.Update, &p.Delete, &p.Truncate, &p.ViaRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		pubIdx[p.Name] = len(publications)
+		publications = append(publications, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(publications) == 0 {
+		return nil, nil
+	}
+
+	rows, err = db.QueryContext(ctx, QueryMarkerSQL+publicationTablesSQL, c.Config.IgnoreSchemaRegexp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pubName string
+		var t state.PostgresPublicationTable
+
+		err := rows.Scan(&pubName, &t.SchemaName, &t.RelationName)
+		if err != nil {
+			return nil, err
+		}
+
+		if idx, ok := pubIdx[pubName]; ok {
+			publications[idx].Tables = append(publications[idx].Tables, t)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return publications, nil
+}
+
 func GetIsReplica(ctx context.Context, logger *util.Logger, db *sql.DB) (bool, error) {
 	isAwsAurora, err := GetIsAwsAurora(ctx, db)
 	if err != nil {
@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pganalyze/collector/config"
@@ -46,6 +48,32 @@ func GetDatabasesToCollect(config config.ServerConfig, databases []state.Postgre
 	return schemaDbNames
 }
 
+// Each database has its own timeout within the overall schema collection timeout,
+// so that one database with a slow catalog doesn't starve all others
+const schemaCollectionDatabaseTimeout = 3 * time.Minute
+
+// Returns how many databases can have their schema information collected in
+// parallel - the connection pool keeps half of max_collector_connections for
+// other collector tasks, and the full snapshot itself holds one connection
+func schemaCollectionConcurrency(config config.ServerConfig) int {
+	limit := config.MaxCollectorConnections - config.MaxCollectorConnections/2 - 1
+	return max(min(config.SchemaCollectionConcurrency, limit), 1)
+}
+
+// Schema information collected for a single database
+type schemaResult struct {
+	databaseOid  state.Oid
+	relations    []state.PostgresRelation
+	schemaStats  *state.SchemaStats
+	functions    []state.PostgresFunction
+	extensions   []state.PostgresExtension
+	types        []state.PostgresType
+	publications []state.PostgresPublication
+
+	err      error
+	timedOut bool
+}
+
 func CollectAllSchemas(ctx context.Context, c *Collection, server *state.Server, ps state.PersistedState, ts state.TransientState) (state.PersistedState, state.TransientState, error) {
 	ctxSchema, cancel := context.WithTimeout(ctx, schemaCollectionTimeout)
 	defer cancel()
@@ -55,45 +83,31 @@ func CollectAllSchemas(ctx context.Context, c *Collection, server *state.Server,
 	ps.SchemaStats = make(map[state.Oid]*state.SchemaStats)
 	ps.Functions = []state.PostgresFunction{}
 
+	var dbNames []string
 	collected := make(map[string]bool)
 	for _, dbName := range GetDatabasesToCollect(server.Config, ts.Databases) {
 		if _, ok := collected[dbName]; ok {
 			continue
 		}
+		collected[dbName] = true
 		c.SelfTest.MarkMonitoredDb(dbName)
+		dbNames = append(dbNames, dbName)
+	}
 
-		collected[dbName] = true
-		psNext, tsNext, databaseOid, err := collectOneSchema(ctxSchema, c, server, ps, ts, dbName)
-		if err != nil {
-			// If the outer context failed, return an error to the caller
-			if ctx.Err() != nil {
-				c.SelfTest.MarkRemainingDbCollectionAspectError(state.CollectionAspectSchema, err.Error())
-				return ps, ts, err
-			}
-			// If the schema context failed, stop doing any further collection.
-			// We avoid returning an error in this case to allow other collector
-			// functions to report their data, and send any schema information
-			// we already collected.
-			if ctxSchema.Err() != nil {
-				c.Logger.PrintWarning("Failed to collect schema metadata for database %s and all remaining databases: %s", dbName, err)
-				c.SelfTest.MarkRemainingDbCollectionAspectError(state.CollectionAspectSchema, err.Error())
-				return ps, ts, nil
-			}
-			warning := "Failed to collect schema metadata for database %s: %s"
-			c.SelfTest.MarkDbCollectionAspectError(dbName, state.CollectionAspectSchema, "%s", err.Error())
-			if c.GlobalOpts.TestRun {
-				c.Logger.PrintWarning(warning, dbName, err)
-			} else {
-				c.Logger.PrintVerbose(warning, dbName, err)
-			}
+	results := collectSchemas(ctxSchema, dbNames, schemaCollectionConcurrency(server.Config), func(ctx context.Context, dbName string) *schemaResult {
+		return collectOneSchema(ctx, schemaCollectionDatabaseTimeout, func(ctx context.Context, result *schemaResult) error {
+			return collectOneSchemaInto(ctx, c, server, ts, dbName, result)
+		})
+	})
 
-			continue
-		}
-		ps = psNext
-		ts = tsNext
-		ts.DatabaseOidsWithLocalCatalog = append(ts.DatabaseOidsWithLocalCatalog, databaseOid)
-		c.SelfTest.MarkDbCollectionAspectOk(dbName, state.CollectionAspectSchema)
+	// If the outer context failed, return an error to the caller
+	if ctx.Err() != nil {
+		c.SelfTest.MarkRemainingDbCollectionAspectError(state.CollectionAspectSchema, ctx.Err().Error())
+		return ps, ts, ctx.Err()
 	}
+
+	ps, ts = mergeSchemaResults(c, dbNames, results, ps, ts)
+
 	schemaTableLimit := int(server.Grant.Load().Config.SchemaTableLimit)
 	if schemaTableLimit == 0 {
 		schemaTableLimit = defaultSchemaTableLimit
@@ -112,95 +126,168 @@ func CollectAllSchemas(ctx context.Context, c *Collection, server *state.Server,
 	return ps, ts, nil
 }
 
-func collectOneSchema(ctx context.Context, c *Collection, server *state.Server, ps state.PersistedState, ts state.TransientState, dbName string) (psOut state.PersistedState, tsOut state.TransientState, databaseOid state.Oid, err error) {
+// Runs collect for each database, with up to concurrency databases at a time,
+// and stops starting new ones once the context expires
+//
+// Results are kept in the order of databases, so they are merged the same way
+// regardless of which database finishes first (nil if collection never started)
+func collectSchemas(ctx context.Context, dbNames []string, concurrency int, collect func(ctx context.Context, dbName string) *schemaResult) []*schemaResult {
+	results := make([]*schemaResult, len(dbNames))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for idx, dbName := range dbNames {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(idx int, dbName string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[idx] = collect(ctx, dbName)
+		}(idx, dbName)
+	}
+	wg.Wait()
+	return results
+}
+
+// Adds the schema information of each successfully collected database, and
+// reports databases that failed or were skipped
+func mergeSchemaResults(c *Collection, dbNames []string, results []*schemaResult, ps state.PersistedState, ts state.TransientState) (state.PersistedState, state.TransientState) {
+	for idx, dbName := range dbNames {
+		result := results[idx]
+		if result == nil {
+			// The overall schema collection timeout expired before we got to this
+			// database. We avoid returning an error in this case to allow other
+			// collector functions to report their data, and send any schema
+			// information we already collected.
+			c.Logger.PrintWarning("Skipped collecting schema metadata for database %s: schema collection timed out", dbName)
+			c.SelfTest.MarkDbCollectionAspectError(dbName, state.CollectionAspectSchema, "schema collection timed out")
+			continue
+		}
+		if result.err != nil {
+			warning := "Failed to collect schema metadata for database %s: %s"
+			c.SelfTest.MarkDbCollectionAspectError(dbName, state.CollectionAspectSchema, "%s", result.err.Error())
+			if c.GlobalOpts.TestRun || result.timedOut {
+				c.Logger.PrintWarning(warning, dbName, result.err)
+			} else {
+				c.Logger.PrintVerbose(warning, dbName, result.err)
+			}
+			continue
+		}
+
+		ps.Relations = append(ps.Relations, result.relations...)
+		ps.SchemaStats[result.databaseOid] = result.schemaStats
+		ps.Functions = append(ps.Functions, result.functions...)
+		ts.Extensions = append(ts.Extensions, result.extensions...)
+		ts.Types = append(ts.Types, result.types...)
+		ts.Replication.Publications = append(ts.Replication.Publications, result.publications...)
+		ts.DatabaseOidsWithLocalCatalog = append(ts.DatabaseOidsWithLocalCatalog, result.databaseOid)
+		c.SelfTest.MarkDbCollectionAspectOk(dbName, state.CollectionAspectSchema)
+	}
+	return ps, ts
+}
+
+// Collects the schema information of a single database, with its own timeout
+func collectOneSchema(ctx context.Context, timeout time.Duration, collect func(ctx context.Context, result *schemaResult) error) *schemaResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := &schemaResult{}
+	result.err = collect(ctx, result)
+	result.timedOut = result.err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded)
+	return result
+}
+
+func collectOneSchemaInto(ctx context.Context, c *Collection, server *state.Server, ts state.TransientState, dbName string, result *schemaResult) error {
 	schemaConnection, err := EstablishConnection(ctx, server, c.Logger, c.GlobalOpts, dbName)
 	if err != nil {
-		return ps, ts, 0, fmt.Errorf("error connecting: %s", err)
+		return fmt.Errorf("error connecting: %s", err)
 	}
 	defer schemaConnection.Close()
 
-	databaseOid, err = CurrentDatabaseOid(ctx, schemaConnection)
+	result.databaseOid, err = CurrentDatabaseOid(ctx, schemaConnection)
 	if err != nil {
-		return ps, ts, 0, fmt.Errorf("error getting database OID: %s", err)
+		return fmt.Errorf("error getting database OID: %s", err)
 	}
 
-	ps.SchemaStats[databaseOid] = &state.SchemaStats{
+	result.schemaStats = &state.SchemaStats{
 		RelationStats:         make(state.PostgresRelationStatsMap),
 		IndexStats:            make(state.PostgresIndexStatsMap),
 		ColumnStats:           make(state.PostgresColumnStatsMap),
 		RelationStatsExtended: make(state.PostgresRelationStatsExtendedMap),
 	}
 
-	psOut, tsOut, err = collectSchemaData(ctx, c, schemaConnection, ps, ts, databaseOid, server, dbName)
-	if err != nil {
-		return ps, ts, 0, err
-	}
-
-	return psOut, tsOut, databaseOid, nil
+	return collectSchemaData(ctx, c, schemaConnection, ts, result.databaseOid, server, dbName, result)
 }
 
-func collectSchemaData(ctx context.Context, c *Collection, db *sql.DB, ps state.PersistedState, ts state.TransientState, databaseOid state.Oid, server *state.Server, dbName string) (state.PersistedState, state.TransientState, error) {
+func collectSchemaData(ctx context.Context, c *Collection, db *sql.DB, ts state.TransientState, databaseOid state.Oid, server *state.Server, dbName string, result *schemaResult) error {
 	newFunctions, err := GetFunctions(ctx, c.Logger, db, ts.Version, databaseOid, server.Config.IgnoreSchemaRegexp, false)
 	if err != nil {
-		return ps, ts, fmt.Errorf("error collecting stored procedure metadata: %s", err)
+		return fmt.Errorf("error collecting stored procedure metadata: %s", err)
 	}
-	ps.Functions = append(ps.Functions, newFunctions...)
+	result.functions = newFunctions
 
 	c = c.ForCurrentDatabase(newFunctions)
 
 	if c.GlobalOpts.CollectPostgresRelations {
-		newRelations, err := GetRelations(ctx, c, db, databaseOid)
+		result.relations, err = GetRelations(ctx, c, db, databaseOid)
 		if err != nil {
-			return ps, ts, fmt.Errorf("error collecting table/index metadata: %s", err)
+			return fmt.Errorf("error collecting table/index metadata: %s", err)
 		}
-		ps.Relations = append(ps.Relations, newRelations...)
 
 		newRelationStats, err := GetRelationStats(ctx, c, db, databaseOid, ts)
 		if err != nil {
-			return ps, ts, fmt.Errorf("error collecting table statistics: %s", err)
+			return fmt.Errorf("error collecting table statistics: %s", err)
 		}
 		for k, v := range newRelationStats {
-			ps.SchemaStats[databaseOid].RelationStats[k] = v
+			result.schemaStats.RelationStats[k] = v
 		}
 
 		newIndexStats, err := GetIndexStats(ctx, c, db, databaseOid, ts)
 		if err != nil {
-			return ps, ts, fmt.Errorf("error collecting index statistics: %s", err)
+			return fmt.Errorf("error collecting index statistics: %s", err)
 		}
 		for k, v := range newIndexStats {
-			ps.SchemaStats[databaseOid].IndexStats[k] = v
+			result.schemaStats.IndexStats[k] = v
 		}
 
 		newColumnStats, err := GetColumnStats(ctx, c, db, dbName)
 		if err != nil {
-			return ps, ts, fmt.Errorf("error collecting column statistics: %s", err)
+			return fmt.Errorf("error collecting column statistics: %s", err)
 		}
 		for k, v := range newColumnStats {
-			ps.SchemaStats[databaseOid].ColumnStats[k] = v
+			result.schemaStats.ColumnStats[k] = v
 		}
 
 		if c.PostgresVersion.Numeric >= state.PostgresVersion12 {
 			newRelationStatsExtended, err := GetRelationStatsExtended(ctx, c, db, dbName)
 			if err != nil {
-				return ps, ts, fmt.Errorf("error collecting extended relation statistics: %s", err)
+				return fmt.Errorf("error collecting extended relation statistics: %s", err)
 			}
 			for k, v := range newRelationStatsExtended {
-				ps.SchemaStats[databaseOid].RelationStatsExtended[k] = v
+				result.schemaStats.RelationStatsExtended[k] = v
 			}
 		}
 	}
 
-	newExtensions, err := GetExtensions(ctx, db, databaseOid)
+	result.extensions, err = GetExtensions(ctx, db, databaseOid)
 	if err != nil {
-		return ps, ts, fmt.Errorf("error collecting extension information: %s", err)
+		return fmt.Errorf("error collecting extension information: %s", err)
 	}
-	ts.Extensions = append(ts.Extensions, newExtensions...)
 
-	newTypes, err := GetTypes(ctx, c, db, databaseOid)
+	result.types, err = GetTypes(ctx, c, db, databaseOid)
 	if err != nil {
-		return ps, ts, fmt.Errorf("error collecting custom types: %s", err)
+		return fmt.Errorf("error collecting custom types: %s", err)
 	}
-	ts.Types = append(ts.Types, newTypes...)
 
-	return ps, ts, nil
+	result.publications, err = GetPublications(ctx, c, db, databaseOid)
+	if err != nil {
+		return fmt.Errorf("error collecting publications: %s", err)
+	}
+
+	return nil
 }
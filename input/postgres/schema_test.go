@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+var schemaCollectionConcurrencyTests = []struct {
+	maxCollectorConnections     int
+	schemaCollectionConcurrency int
+	expected                    int
+}{
+	// Defaults
+	{10, 4, 4},
+	// Limited by max_collector_connections, leaving room for pooled connections
+	{10, 8, 4},
+	{20, 8, 8},
+	{4, 4, 1},
+	// Always collects at least one database at a time
+	{1, 4, 1},
+	{10, 0, 1},
+}
+
+func TestSchemaCollectionConcurrency(t *testing.T) {
+	for _, test := range schemaCollectionConcurrencyTests {
+		cfg := config.ServerConfig{
+			MaxCollectorConnections:     test.maxCollectorConnections,
+			SchemaCollectionConcurrency: test.schemaCollectionConcurrency,
+		}
+		actual := schemaCollectionConcurrency(cfg)
+		if actual != test.expected {
+			t.Errorf("schemaCollectionConcurrency with max_collector_connections = %d, schema_collection_concurrency = %d: expected %d; got %d",
+				test.maxCollectorConnections, test.schemaCollectionConcurrency, test.expected, actual)
+		}
+	}
+}
+
+func makeSchemaTestCollection() *Collection {
+	return &Collection{
+		Logger:   &util.Logger{Destination: log.New(io.Discard, "", 0)},
+		SelfTest: state.MakeSelfTest(),
+	}
+}
+
+func makeSchemaTestResult(oid state.Oid, name string) *schemaResult {
+	return &schemaResult{
+		databaseOid: oid,
+		relations:   []state.PostgresRelation{{Oid: oid * 10, DatabaseOid: oid, RelationName: name}},
+		schemaStats: &state.SchemaStats{},
+		functions:   []state.PostgresFunction{{DatabaseOid: oid, FunctionName: name}},
+		extensions:  []state.PostgresExtension{{DatabaseOid: oid, ExtensionName: name}},
+	}
+}
+
+func TestCollectAllSchemasParallel(t *testing.T) {
+	dbNames := []string{"db1", "db2", "db3", "db4", "db5"}
+	oids := map[string]state.Oid{"db1": 1, "db2": 2, "db3": 3, "db4": 4, "db5": 5}
+
+	var running, maxRunning atomic.Int32
+	results := collectSchemas(context.Background(), dbNames, 2, func(ctx context.Context, dbName string) *schemaResult {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			prev := maxRunning.Load()
+			if current <= prev || maxRunning.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+		// Databases finish in reverse order
+		time.Sleep(time.Duration(len(dbNames)-int(oids[dbName])) * 5 * time.Millisecond)
+		return makeSchemaTestResult(oids[dbName], dbName)
+	})
+	if maxRunning.Load() > 2 {
+		t.Errorf("want at most 2 databases collected in parallel; got %d", maxRunning.Load())
+	}
+
+	c := makeSchemaTestCollection()
+	ps := state.PersistedState{SchemaStats: make(map[state.Oid]*state.SchemaStats)}
+	ps, ts := mergeSchemaResults(c, dbNames, results, ps, state.TransientState{})
+
+	var relationNames, functionNames, extensionNames []string
+	for _, relation := range ps.Relations {
+		relationNames = append(relationNames, relation.RelationName)
+	}
+	for _, function := range ps.Functions {
+		functionNames = append(functionNames, function.FunctionName)
+	}
+	for _, extension := range ts.Extensions {
+		extensionNames = append(extensionNames, extension.ExtensionName)
+	}
+	for what, names := range map[string][]string{"relations": relationNames, "functions": functionNames, "extensions": extensionNames} {
+		if !slices.Equal(names, dbNames) {
+			t.Errorf("%s: want merged in database order %v; got %v", what, dbNames, names)
+		}
+	}
+	if !slices.Equal(ts.DatabaseOidsWithLocalCatalog, []state.Oid{1, 2, 3, 4, 5}) {
+		t.Errorf("want database OIDs in database order; got %v", ts.DatabaseOidsWithLocalCatalog)
+	}
+	if len(ps.SchemaStats) != 5 {
+		t.Errorf("want schema stats for 5 databases; got %d", len(ps.SchemaStats))
+	}
+	for _, dbName := range dbNames {
+		if status := c.SelfTest.AllDbAspectStatuses[state.CollectionAspectSchema][dbName]; status == nil || status.State != state.CollectionStateOkay {
+			t.Errorf("%s: want schema aspect ok; got %+v", dbName, status)
+		}
+	}
+}
+
+func TestCollectAllSchemasFailures(t *testing.T) {
+	dbNames := []string{"ok", "failing", "slow", "cancelled", "skipped"}
+
+	// "slow" hits the per-database timeout, and the overall timeout expires
+	// while "cancelled" is still running, so "skipped" never starts
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	results := collectSchemas(ctx, dbNames, 1, func(ctx context.Context, dbName string) *schemaResult {
+		return collectOneSchema(ctx, 100*time.Millisecond, func(ctx context.Context, result *schemaResult) error {
+			switch dbName {
+			case "ok":
+				*result = *makeSchemaTestResult(1, dbName)
+				return nil
+			case "failing":
+				return errors.New("error connecting: permission denied")
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	})
+
+	for idx, dbName := range []string{"slow", "cancelled"} {
+		if result := results[idx+2]; result == nil || !result.timedOut {
+			t.Errorf("%s: want timed out result; got %+v", dbName, result)
+		}
+	}
+	if results[4] != nil {
+		t.Errorf("skipped: want no result; got %+v", results[4])
+	}
+
+	c := makeSchemaTestCollection()
+	ps := state.PersistedState{SchemaStats: make(map[state.Oid]*state.SchemaStats)}
+	ps, ts := mergeSchemaResults(c, dbNames, results, ps, state.TransientState{})
+
+	if len(ps.Relations) != 1 || ps.Relations[0].RelationName != "ok" {
+		t.Errorf("want only relations of the successful database; got %+v", ps.Relations)
+	}
+	if !slices.Equal(ts.DatabaseOidsWithLocalCatalog, []state.Oid{1}) {
+		t.Errorf("want only OID of the successful database; got %v", ts.DatabaseOidsWithLocalCatalog)
+	}
+	expected := map[string]struct {
+		state state.CollectionStateCode
+		msg   string
+	}{
+		"ok":        {state.CollectionStateOkay, "ok"},
+		"failing":   {state.CollectionStateError, "error connecting: permission denied"},
+		"slow":      {state.CollectionStateError, "context deadline exceeded"},
+		"cancelled": {state.CollectionStateError, "context deadline exceeded"},
+		"skipped":   {state.CollectionStateError, "schema collection timed out"},
+	}
+	for dbName, e := range expected {
+		status := c.SelfTest.AllDbAspectStatuses[state.CollectionAspectSchema][dbName]
+		if status == nil || status.State != e.state || status.Msg != e.msg {
+			t.Errorf("%s: want state %v with message %q; got %+v", dbName, e.state, e.msg, status)
+		}
+	}
+}
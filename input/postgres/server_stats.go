@@ -218,3 +218,143 @@ func GetPgStatIo(ctx context.Context, c *Collection, db *sql.DB) (stats state.Po
 	err = rows.Err()
 	return
 }
+
+const walStatsSQLPg14 string = `
+SELECT wal_records, wal_fpi, wal_bytes::bigint, wal_buffers_full,
+	   wal_write, wal_sync, wal_write_time, wal_sync_time, stats_reset
+  FROM pg_catalog.pg_stat_wal
+`
+
+const walStatsSQLPg18 string = `
+SELECT wal_records, wal_fpi, wal_bytes::bigint, wal_buffers_full,
+	   0, 0, 0, 0, stats_reset
+  FROM pg_catalog.pg_stat_wal
+`
+
+const checkpointerStatsSQLDefault string = `
+SELECT checkpoints_timed, checkpoints_req, 0, 0, 0, 0,
+	   checkpoint_write_time, checkpoint_sync_time, buffers_checkpoint, 0, stats_reset
+  FROM pg_catalog.pg_stat_bgwriter
+`
+
+const checkpointerStatsSQLPg17 string = `
+SELECT num_timed, num_requested, 0, restartpoints_timed, restartpoints_req, restartpoints_done,
+	   write_time, sync_time, buffers_written, 0, stats_reset
+  FROM pg_catalog.pg_stat_checkpointer
+`
+
+const checkpointerStatsSQLPg18 string = `
+SELECT num_timed, num_requested, num_done, restartpoints_timed, restartpoints_req, restartpoints_done,
+	   write_time, sync_time, buffers_written, slru_written, stats_reset
+  FROM pg_catalog.pg_stat_checkpointer
+`
+
+const bgwriterStatsSQLDefault string = `
+SELECT buffers_clean, maxwritten_clean, buffers_alloc, buffers_backend, buffers_backend_fsync, stats_reset
+  FROM pg_catalog.pg_stat_bgwriter
+`
+
+const bgwriterStatsSQLPg17 string = `
+SELECT buffers_clean, maxwritten_clean, buffers_alloc, 0, 0, stats_reset
+  FROM pg_catalog.pg_stat_bgwriter
+`
+
+const archiverStatsSQL string = `
+SELECT archived_count, last_archived_wal, last_archived_time,
+	   failed_count, last_failed_wal, last_failed_time, stats_reset
+  FROM pg_catalog.pg_stat_archiver
+`
+
+const slruStatsSQLPg13 string = `
+SELECT name, blks_zeroed, blks_hit, blks_read, blks_written, blks_exists,
+	   flushes, truncates, stats_reset
+  FROM pg_catalog.pg_stat_slru
+`
+
+// GetServerSharedStats - Collects the server-wide cumulative statistics for WAL,
+// the checkpointer, the background writer, the WAL archiver and SLRU caches
+func GetServerSharedStats(ctx context.Context, c *Collection, db *sql.DB) (stats state.PostgresServerSharedStats, err error) {
+	if c.PostgresVersion.Numeric >= state.PostgresVersion14 {
+		var walStatsSQL string
+		if c.PostgresVersion.Numeric >= state.PostgresVersion18 {
+			walStatsSQL = walStatsSQLPg18
+		} else {
+			walStatsSQL = walStatsSQLPg14
+		}
+		var s state.PostgresServerWalStats
+		err = db.QueryRowContext(ctx, QueryMarkerSQL+walStatsSQL).Scan(
+			&s.Records, &s.Fpi, &s.Bytes, &s.BuffersFull,
+			&s.Write, &s.Sync, &s.WriteTime, &s.SyncTime, &s.StatsReset,
+		)
+		if err != nil {
+			return
+		}
+		stats.Wal = &s
+	}
+
+	var checkpointerStatsSQL, bgwriterStatsSQL string
+	if c.PostgresVersion.Numeric >= state.PostgresVersion18 {
+		checkpointerStatsSQL = checkpointerStatsSQLPg18
+		bgwriterStatsSQL = bgwriterStatsSQLPg17
+	} else if c.PostgresVersion.Numeric >= state.PostgresVersion17 {
+		checkpointerStatsSQL = checkpointerStatsSQLPg17
+		bgwriterStatsSQL = bgwriterStatsSQLPg17
+	} else {
+		checkpointerStatsSQL = checkpointerStatsSQLDefault
+		bgwriterStatsSQL = bgwriterStatsSQLDefault
+	}
+
+	var checkpointer state.PostgresServerCheckpointerStats
+	err = db.QueryRowContext(ctx, QueryMarkerSQL+checkpointerStatsSQL).Scan(
+		&checkpointer.NumTimed, &checkpointer.NumRequested, &checkpointer.NumDone,
+		&checkpointer.RestartpointsTimed, &checkpointer.RestartpointsReq, &checkpointer.RestartpointsDone,
+		&checkpointer.WriteTime, &checkpointer.SyncTime, &checkpointer.BuffersWritten,
+		&checkpointer.SlruWritten, &checkpointer.StatsReset,
+	)
+	if err != nil {
+		return
+	}
+	stats.Checkpointer = &checkpointer
+
+	var bgwriter state.PostgresServerBgwriterStats
+	err = db.QueryRowContext(ctx, QueryMarkerSQL+bgwriterStatsSQL).Scan(
+		&bgwriter.BuffersClean, &bgwriter.MaxwrittenClean, &bgwriter.BuffersAlloc,
+		&bgwriter.BuffersBackend, &bgwriter.BuffersBackendFsync, &bgwriter.StatsReset,
+	)
+	if err != nil {
+		return
+	}
+	stats.Bgwriter = &bgwriter
+
+	var archiver state.PostgresServerArchiverStats
+	err = db.QueryRowContext(ctx, QueryMarkerSQL+archiverStatsSQL).Scan(
+		&archiver.ArchivedCount, &archiver.LastArchivedWal, &archiver.LastArchivedTime,
+		&archiver.FailedCount, &archiver.LastFailedWal, &archiver.LastFailedTime, &archiver.StatsReset,
+	)
+	if err != nil {
+		return
+	}
+	stats.Archiver = &archiver
+
+	if c.PostgresVersion.Numeric < state.PostgresVersion13 {
+		return
+	}
+	rows, err := db.QueryContext(ctx, QueryMarkerSQL+slruStatsSQLPg13)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	stats.Slru = make(state.PostgresServerSlruStatsMap)
+	for rows.Next() {
+		var name string
+		var s state.PostgresServerSlruStats
+		err = rows.Scan(&name, &s.BlksZeroed, &s.BlksHit, &s.BlksRead, &s.BlksWritten,
+			&s.BlksExists, &s.Flushes, &s.Truncates, &s.StatsReset)
+		if err != nil {
+			return
+		}
+		stats.Slru[name] = s
+	}
+	err = rows.Err()
+	return
+}
@@ -0,0 +1,166 @@
+package aiven
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pganalyze/collector/util"
+)
+
+const apiBaseURL = "https://api.aiven.io"
+
+type Client struct {
+	http.Client
+
+	BaseURL   string
+	Token     string
+	ProjectID string
+	ServiceID string
+}
+
+type ServiceInfo struct {
+	CloudName    string `json:"cloud_name"`
+	CreateTime   string `json:"create_time"`
+	DiskSpaceMB  int64  `json:"disk_space_mb"`
+	NodeCount    int32  `json:"node_count"`
+	NodeCPUCount int32  `json:"node_cpu_count"`
+	NodeMemoryMB int64  `json:"node_memory_mb"`
+	Plan         string `json:"plan"`
+	State        string `json:"state"`
+}
+
+type serviceResponse struct {
+	Service ServiceInfo `json:"service"`
+}
+
+// MetricData - Metric values in the Google Charts DataTable format, with the
+// first column being the time, and one column per service node
+type MetricData struct {
+	Cols []struct {
+		Label string `json:"label"`
+		Type  string `json:"type"`
+	} `json:"cols"`
+	Rows [][]any `json:"rows"`
+}
+
+type metricsResponse struct {
+	Metrics map[string]struct {
+		Data MetricData `json:"data"`
+	} `json:"metrics"`
+}
+
+type LogEntry struct {
+	Msg     string `json:"msg"`
+	Service string `json:"service"`
+	Time    string `json:"time"`
+	Unit    string `json:"unit"`
+}
+
+type logsRequest struct {
+	Limit     int    `json:"limit"`
+	Offset    string `json:"offset,omitempty"`
+	SortOrder string `json:"sort_order"`
+}
+
+type LogsResponse struct {
+	FirstLogOffset string     `json:"first_log_offset"`
+	Logs           []LogEntry `json:"logs"`
+	Offset         string     `json:"offset"`
+}
+
+func (c *Client) servicePath() string {
+	return "/v1/project/" + url.PathEscape(c.ProjectID) + "/service/" + url.PathEscape(c.ServiceID)
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, reqBody any, respBody any) error {
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "aivenv1 "+c.Token)
+	req.Header.Set("User-Agent", util.CollectorNameAndVersion)
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK || len(data) == 0 {
+		return fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(data))
+	}
+	return json.Unmarshal(data, respBody)
+}
+
+func (c *Client) GetServiceInfo(ctx context.Context) (*ServiceInfo, error) {
+	var resp serviceResponse
+	err := c.do(ctx, "GET", c.servicePath(), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Service, nil
+}
+
+// GetMetrics - Gets the service metrics for the last hour, keyed by metric name
+// (e.g. "cpu_usage")
+func (c *Client) GetMetrics(ctx context.Context) (map[string]MetricData, error) {
+	var resp metricsResponse
+	err := c.do(ctx, "POST", c.servicePath()+"/metrics", map[string]string{"period": "hour"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	metrics := make(map[string]MetricData)
+	for name, metric := range resp.Metrics {
+		metrics[name] = metric.Data
+	}
+	return metrics, nil
+}
+
+// GetLogs - Gets service log entries in chronological order, starting after the
+// specified offset (or with the oldest available entry if offset is empty)
+func (c *Client) GetLogs(ctx context.Context, offset string, limit int) (*LogsResponse, error) {
+	var resp LogsResponse
+	err := c.do(ctx, "POST", c.servicePath()+"/logs", logsRequest{Limit: limit, Offset: offset, SortOrder: "asc"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// latestValue returns the most recent value of the metric
+//
+// For services with standby nodes each node has its own column, in which case
+// only the first node's values are used.
+func latestValue(data MetricData) (float64, bool) {
+	for i := len(data.Rows) - 1; i >= 0; i-- {
+		row := data.Rows[i]
+		if len(row) < 2 {
+			continue
+		}
+		if value, ok := row[1].(float64); ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
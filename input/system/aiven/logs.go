@@ -0,0 +1,173 @@
+package aiven
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const (
+	logsPageSize = 500
+
+	// Limits the number of requests per download, e.g. when catching up after
+	// the collector was stopped for a while. Remaining entries are fetched on
+	// the next download.
+	maxLogPagesPerDownload = 100
+)
+
+// Aiven ships Postgres logs through syslog, which adds sequence numbers in
+// front of the log_line_prefix (e.g. "[12-1] pid=123,user=...")
+var syslogSequenceRegexp = regexp.MustCompile(`^\[\d+-\d+\] `)
+
+type logEntry struct {
+	occurredAt time.Time
+	content    string
+}
+
+// DownloadLogFiles fetches new Postgres log entries from the Aiven service logs API.
+// Called by the log download scheduler, on the interval configured for the server.
+func DownloadLogFiles(ctx context.Context, server *state.Server, opts state.CollectionOpts, logger *util.Logger) (
+	state.PersistedLogState,
+	[]state.LogFile, []state.PostgresQuerySample,
+	error,
+) {
+	psl := server.LogPrevState
+	client := newClient(server)
+
+	// Only process lines newer than the log download window (similar to RDS)
+	linesNewerThan := time.Now().UTC().Add(-server.Config.LogDownloadWindow())
+
+	// Keep at most the trailing maxLogParsingSize bytes, discarding older data
+	maxLogParsingSize := server.Config.MaxLogParsingSize()
+	var entries []logEntry
+	var contentSize int
+	var sizeLimitReached bool
+
+	offset := psl.Aiven.Offset
+	for page := 0; page < maxLogPagesPerDownload; page++ {
+		resp, err := client.GetLogs(ctx, offset, logsPageSize)
+		if err != nil {
+			return psl, nil, nil, fmt.Errorf("failed to query logs: %w", err)
+		}
+
+		for _, entry := range resp.Logs {
+			// The service logs also contain entries from other units (e.g. PgBouncer)
+			if !strings.HasPrefix(entry.Unit, "postgresql") {
+				continue
+			}
+			occurredAt, err := time.Parse(time.RFC3339Nano, entry.Time)
+			if err != nil || occurredAt.Before(linesNewerThan) {
+				continue
+			}
+			content := syslogSequenceRegexp.ReplaceAllString(entry.Msg, "")
+			if !strings.HasSuffix(content, "\n") {
+				content += "\n"
+			}
+			entries = append(entries, logEntry{occurredAt: occurredAt, content: content})
+			contentSize += len(content)
+		}
+		for contentSize > maxLogParsingSize && len(entries) > 0 {
+			if !sizeLimitReached {
+				sizeLimitReached = true
+				logger.PrintWarning("Aiven: Log data exceeds %d MB in interval, discarding older data", maxLogParsingSize/1024/1024)
+			}
+			contentSize -= len(entries[0].content)
+			entries = entries[1:]
+		}
+
+		if resp.Offset != "" {
+			offset = resp.Offset
+		}
+		if len(resp.Logs) < logsPageSize || resp.Offset == "" {
+			break
+		}
+		if opts.VeryVerbose {
+			logger.PrintVerbose("Aiven: Fetching next page of logs (received %d entries)", len(resp.Logs))
+		}
+	}
+	psl.Aiven.Offset = offset
+
+	logLines, samples := parseLogEntries(entries, server)
+
+	logFile, err := state.NewLogFile("aiven-logs")
+	if err != nil {
+		return psl, nil, nil, fmt.Errorf("error initializing log file: %w", err)
+	}
+	logFile.LogLines = logLines
+
+	var logFiles []state.LogFile
+	if len(logLines) > 0 {
+		logFiles = append(logFiles, logFile)
+	}
+
+	return psl, logFiles, samples, nil
+}
+
+// parseLogEntries parses the entries using the server's log_line_prefix, using
+// the entry time as the time of the log line, since the Aiven log_line_prefix
+// doesn't include a timestamp
+func parseLogEntries(entries []logEntry, server *state.Server) ([]state.LogLine, []state.PostgresQuerySample) {
+	parser := server.GetLogParser()
+	if parser == nil {
+		return []state.LogLine{}, []state.PostgresQuerySample{}
+	}
+
+	var logLines []state.LogLine
+	var byteStart int64
+	var skippedPrevious bool
+	var err error
+	for _, entry := range entries {
+		logLine, ok := parser.ParseLine(entry.content)
+		entryByteStart := byteStart
+		byteStart += int64(len(entry.content))
+		if !ok {
+			// Assume that lines without a prefix continue the previous line
+			if len(logLines) > 0 && !skippedPrevious && logLine.Content != "" {
+				logLines[len(logLines)-1].Content += logLine.Content
+				logLines[len(logLines)-1].ByteEnd = byteStart
+			}
+			continue
+		}
+		skippedPrevious = logLine.LogLevel == pganalyze_collector.LogLineInformation_LOCATION ||
+			logLine.LogLevel == pganalyze_collector.LogLineInformation_BACKTRACE
+		if skippedPrevious {
+			continue
+		}
+
+		if logLine.OccurredAt.IsZero() {
+			logLine.OccurredAt = entry.occurredAt
+		}
+		logLine.ByteStart = entryByteStart
+		logLine.ByteContentStart = entryByteStart + int64(len(entry.content)-len(logLine.Content))
+		logLine.ByteEnd = byteStart
+		logLines = append(logLines, logLine)
+	}
+
+	// Ignore loglines that are ignored server-wide (e.g. because they are
+	// log_statement=all/log_duration=on lines), after accounting for them in
+	// the log-derived query statistics. This runs after multi-line log lines
+	// have been stitched together, so statistics see the full query text.
+	var analyzableLogLines []state.LogLine
+	for _, logLine := range logLines {
+		server.LogQueryStats.AddLogLine(logLine)
+		if server.IgnoreLogLine(logLine.Content) {
+			continue
+		}
+		logLine.UUID, err = uuid.NewV7()
+		if err != nil {
+			continue
+		}
+		analyzableLogLines = append(analyzableLogLines, logLine)
+	}
+
+	return logs.AnalyzeLogLines(analyzableLogLines)
+}
@@ -0,0 +1,164 @@
+package aiven
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Serves the given log entries in pages, using the entry index as the offset
+func newMockLogsServer(t *testing.T, entries []LogEntry, requestedOffsets *[]string) string {
+	apiServer := newMockAPIServer(t, map[string]http.HandlerFunc{
+		"POST /v1/project/my-project/service/my-service/logs": func(w http.ResponseWriter, r *http.Request) {
+			var req logsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			*requestedOffsets = append(*requestedOffsets, req.Offset)
+			start := 0
+			if req.Offset != "" {
+				start, _ = strconv.Atoi(req.Offset)
+			}
+			end := min(start+req.Limit, len(entries))
+			json.NewEncoder(w).Encode(LogsResponse{
+				FirstLogOffset: "0",
+				Logs:           entries[start:end],
+				Offset:         strconv.Itoa(end),
+			})
+		},
+	})
+	return apiServer.URL
+}
+
+func TestDownloadLogFiles(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	now := time.Now().UTC()
+	entryTime := func(offset time.Duration) string {
+		return now.Add(offset).Format(time.RFC3339Nano)
+	}
+
+	entries := []LogEntry{
+		{Time: entryTime(-time.Hour), Unit: "postgresql-16.service", Msg: "[1-1] pid=100,user=app,db=app,app=psql,client=10.0.0.1 LOG:  too old"},
+		{Time: entryTime(-3 * time.Second), Unit: "pgbouncer.service", Msg: "stats: 0 xacts/s"},
+		{Time: entryTime(-2 * time.Second), Unit: "postgresql-16.service", Msg: "[2-1] pid=101,user=app,db=app,app=psql,client=10.0.0.1 ERROR:  relation \"foo\" does not exist at character 15"},
+		{Time: entryTime(-2 * time.Second), Unit: "postgresql-16.service", Msg: "[2-2] pid=101,user=app,db=app,app=psql,client=10.0.0.1 STATEMENT:  SELECT * FROM foo"},
+	}
+	for i := 0; i < logsPageSize; i++ {
+		entries = append(entries, LogEntry{Time: entryTime(-time.Second), Unit: "postgresql-16.service", Msg: fmt.Sprintf("[%d-1] pid=102,user=app,db=app,app=psql,client=10.0.0.1 LOG:  checkpoint starting: time", i+3)})
+	}
+
+	var requestedOffsets []string
+	server := makeTestServer(newMockLogsServer(t, entries, &requestedOffsets))
+	server.LogParser = logs.NewLogParser(logs.LogPrefixCustom11, nil, false)
+
+	psl, logFiles, _, err := DownloadLogFiles(context.Background(), server, state.CollectionOpts{}, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The first page is full, so the next page is requested as well
+	if len(requestedOffsets) != 2 || requestedOffsets[0] != "" || requestedOffsets[1] != strconv.Itoa(logsPageSize) {
+		t.Errorf("want requests with offsets [\"\" %d]; got %q", logsPageSize, requestedOffsets)
+	}
+	if psl.Aiven.Offset != strconv.Itoa(len(entries)) {
+		t.Errorf("want offset %d; got %s", len(entries), psl.Aiven.Offset)
+	}
+	if len(logFiles) != 1 {
+		t.Fatalf("want 1 log file; got %d", len(logFiles))
+	}
+	logLines := logFiles[0].LogLines
+	if len(logLines) != 2+logsPageSize {
+		t.Fatalf("want %d log lines; got %d", 2+logsPageSize, len(logLines))
+	}
+	if logLines[0].LogLevel != pganalyze_collector.LogLineInformation_ERROR || logLines[0].Username != "app" || logLines[0].BackendPid != 101 {
+		t.Errorf("want ERROR line from pid 101; got %+v", logLines[0])
+	}
+	if logLines[0].Classification != pganalyze_collector.LogLineInformation_RELATION_DOES_NOT_EXIST {
+		t.Errorf("want RELATION_DOES_NOT_EXIST classification; got %s", logLines[0].Classification)
+	}
+	if logLines[1].LogLevel != pganalyze_collector.LogLineInformation_STATEMENT || logLines[1].ParentUUID != logLines[0].UUID {
+		t.Errorf("want STATEMENT line associated with the ERROR line; got %+v", logLines[1])
+	}
+	if expected, _ := time.Parse(time.RFC3339Nano, entries[2].Time); !logLines[0].OccurredAt.Equal(expected) {
+		t.Errorf("want occurred at %s; got %s", expected, logLines[0].OccurredAt)
+	}
+
+	// The next download continues from the stored offset
+	requestedOffsets = nil
+	server.LogPrevState = psl
+	_, logFiles, _, err = DownloadLogFiles(context.Background(), server, state.CollectionOpts{}, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requestedOffsets) != 1 || requestedOffsets[0] != strconv.Itoa(len(entries)) {
+		t.Errorf("want request with offset %d; got %q", len(entries), requestedOffsets)
+	}
+	if len(logFiles) != 0 {
+		t.Errorf("want no log files; got %d", len(logFiles))
+	}
+}
+
+func TestDownloadLogFilesLogQueryStats(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	entryTime := time.Now().UTC().Add(-time.Second).Format(time.RFC3339Nano)
+	entries := []LogEntry{
+		{Time: entryTime, Unit: "postgresql-16.service", Msg: "[1-1] pid=101,user=app,db=app,app=psql,client=10.0.0.1 LOG:  statement: SELECT *"},
+		{Time: entryTime, Unit: "postgresql-16.service", Msg: "[1-2] \tFROM users WHERE id = 1"},
+		{Time: entryTime, Unit: "postgresql-16.service", Msg: "[2-1] pid=101,user=app,db=app,app=psql,client=10.0.0.1 LOG:  duration: 2.000 ms"},
+		{Time: entryTime, Unit: "postgresql-16.service", Msg: "[3-1] pid=102,user=app,db=app,app=psql,client=10.0.0.1 LOG:  checkpoint starting: time"},
+	}
+
+	var requestedOffsets []string
+	server := makeTestServer(newMockLogsServer(t, entries, &requestedOffsets))
+	server.LogParser = logs.NewLogParser(logs.LogPrefixCustom11, nil, false)
+	server.LogIgnoreFlags = state.LOG_IGNORE_STATEMENT | state.LOG_IGNORE_DURATION
+	server.LogQueryStats = state.NewLogQueryStats("unparsable")
+
+	_, logFiles, _, err := DownloadLogFiles(context.Background(), server, state.CollectionOpts{}, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The ignored statement and duration lines are not sent, but still count
+	// towards the log-derived query statistics
+	if len(logFiles) != 1 || len(logFiles[0].LogLines) != 1 || logFiles[0].LogLines[0].Content != "checkpoint starting: time\n" {
+		t.Fatalf("want only the checkpoint log line; got %+v", logFiles)
+	}
+	_, _, texts, _ := server.LogQueryStats.Drain(time.Now(), []state.PostgresDatabase{{Oid: 1, Name: "app"}}, []state.PostgresRole{{Oid: 10, Name: "app"}})
+	if len(texts) != 1 {
+		t.Fatalf("want 1 query text; got %+v", texts)
+	}
+	for _, text := range texts {
+		if text != "SELECT *\n\tFROM users WHERE id = $1" {
+			t.Errorf("want normalized multi-line query text; got %q", text)
+		}
+	}
+}
+
+func TestDownloadLogFilesError(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	var requestedOffsets []string
+	server := makeTestServer(newMockLogsServer(t, nil, &requestedOffsets))
+	server.Config.AivenAPIToken = "invalid-token"
+	server.LogPrevState.Aiven.Offset = "42"
+
+	psl, _, _, err := DownloadLogFiles(context.Background(), server, state.CollectionOpts{}, logger)
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if psl.Aiven.Offset != "42" {
+		t.Errorf("want offset to be unchanged; got %s", psl.Aiven.Offset)
+	}
+}
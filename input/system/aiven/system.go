@@ -0,0 +1,118 @@
+package aiven
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// GetSystemState - Gets system information about an Aiven service
+func GetSystemState(ctx context.Context, server *state.Server, logger *util.Logger) (system state.SystemState) {
+	config := server.Config
+	system.Info.Type = state.AivenSystem
+
+	if config.AivenAPIToken == "" {
+		server.SelfTest.MarkCollectionAspectNotAvailable(state.CollectionAspectSystemStats, "not available on this platform without an API token")
+		server.SelfTest.HintCollectionAspect(state.CollectionAspectSystemStats, "Config value aiven_api_token / AIVEN_API_TOKEN is required to collect system stats.")
+		return
+	}
+	client := newClient(server)
+
+	serviceInfo, err := client.GetServiceInfo(ctx)
+	if err != nil {
+		server.SelfTest.MarkCollectionAspectError(state.CollectionAspectSystemStats, "error getting service info: %s", err)
+		logger.PrintError("Aiven/System: Encountered error when getting service info %v\n", err)
+		return
+	}
+
+	metrics, err := client.GetMetrics(ctx)
+	if err != nil {
+		server.SelfTest.MarkCollectionAspectError(state.CollectionAspectSystemStats, "error getting service metrics: %s", err)
+		logger.PrintError("Aiven/System: Encountered error when getting service metrics %v\n", err)
+		return
+	}
+
+	system.CPUInfo.SocketCount = 1
+	system.CPUInfo.LogicalCoreCount = serviceInfo.NodeCPUCount
+	system.CPUInfo.PhysicalCoreCount = serviceInfo.NodeCPUCount
+	if cpuUsage, ok := latestValue(metrics["cpu_usage"]); ok {
+		// Report the remainder as idle, so the UI doesn't scale up the user percentage
+		system.CPUStats = make(state.CPUStatisticMap)
+		system.CPUStats["all"] = state.CPUStatistic{
+			DiffedOnInput: true,
+			DiffedValues: &state.DiffedSystemCPUStats{
+				UserPercent: cpuUsage,
+				IdlePercent: 100 - cpuUsage,
+			},
+		}
+	}
+	if loadAverage, ok := latestValue(metrics["load_average"]); ok {
+		system.Scheduler.Loadavg1min = loadAverage
+	}
+
+	// Memory and disk usage are reported as percentages of the plan's resources
+	totalMemoryBytes := uint64(serviceInfo.NodeMemoryMB) * 1024 * 1024
+	system.Memory.TotalBytes = totalMemoryBytes
+	if memoryUsage, ok := latestValue(metrics["mem_usage"]); ok {
+		// ApplicationBytes is used as "process" memory in the UI, which is the
+		// closest to the used memory
+		system.Memory.ApplicationBytes = uint64(float64(totalMemoryBytes) * memoryUsage / 100)
+	}
+	if memoryAvailable, ok := latestValue(metrics["mem_available"]); ok {
+		system.Memory.AvailableBytes = uint64(float64(totalMemoryBytes) * memoryAvailable / 100)
+	}
+
+	totalDiskBytes := uint64(serviceInfo.DiskSpaceMB) * 1024 * 1024
+	diskUsage, _ := latestValue(metrics["disk_usage"])
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	system.DiskPartitions["/"] = state.DiskPartition{
+		DiskName:   "default",
+		UsedBytes:  uint64(float64(totalDiskBytes) * diskUsage / 100),
+		TotalBytes: totalDiskBytes,
+	}
+
+	readIOPS, _ := latestValue(metrics["diskio_read"])
+	writeIOPS, _ := latestValue(metrics["diskio_writes"])
+	system.Disks = make(state.DiskMap)
+	system.Disks["default"] = state.Disk{}
+	system.DiskStats = make(state.DiskStatsMap)
+	system.DiskStats["default"] = state.DiskStats{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedDiskStats{
+			ReadOperationsPerSecond:  readIOPS,
+			WriteOperationsPerSecond: writeIOPS,
+		},
+	}
+
+	networkReceive, _ := latestValue(metrics["net_receive"])
+	networkSend, _ := latestValue(metrics["net_send"])
+	system.NetworkStats = make(state.NetworkStatsMap)
+	system.NetworkStats["default"] = state.NetworkStats{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedNetworkStats{
+			ReceiveThroughputBytesPerSecond:  uint64(networkReceive),
+			TransmitThroughputBytesPerSecond: uint64(networkSend),
+		},
+	}
+
+	server.SelfTest.MarkCollectionAspectOk(state.CollectionAspectSystemStats)
+
+	return
+}
+
+func newClient(server *state.Server) *Client {
+	config := server.Config
+	client := &Client{
+		BaseURL:   cmp.Or(config.AivenAPIURL, apiBaseURL),
+		Token:     config.AivenAPIToken,
+		ProjectID: config.AivenProjectID,
+		ServiceID: config.AivenServiceID,
+	}
+	if config.HTTPClientWithRetry != nil {
+		client.Client = *config.HTTPClientWithRetry
+	}
+	return client
+}
@@ -0,0 +1,114 @@
+package aiven
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const testServiceResponse = `{"service": {"cloud_name": "google-europe-west1", "disk_space_mb": 81920, "node_count": 2, "node_cpu_count": 2, "node_memory_mb": 4096, "plan": "business-4", "state": "RUNNING"}}`
+
+const testMetricsResponse = `{"metrics": {
+  "cpu_usage": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1 (primary)", "type": "number"}, {"label": "pg-2 (standby)", "type": "number"}], "rows": [["2024-01-01T00:00:00Z", 10.0, 3.0], ["2024-01-01T00:00:30Z", 20.0, 4.0], ["2024-01-01T00:01:00Z", null, null]]}},
+  "load_average": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 1.5]]}},
+  "mem_usage": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 25.0]]}},
+  "mem_available": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 50.0]]}},
+  "disk_usage": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 10.0]]}},
+  "diskio_read": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 12.5]]}},
+  "diskio_writes": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 40.0]]}},
+  "net_receive": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 1000.0]]}},
+  "net_send": {"data": {"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}], "rows": [["2024-01-01T00:00:30Z", 2000.0]]}}
+}}`
+
+func newMockAPIServer(t *testing.T, handlers map[string]http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	for pattern, handler := range handlers {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "aivenv1 test-token" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"message": "Invalid token"}`))
+				return
+			}
+			handler(w, r)
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func makeTestServer(apiURL string) *state.Server {
+	server := state.MakeServer(config.ServerConfig{
+		AivenProjectID: "my-project",
+		AivenServiceID: "my-service",
+		AivenAPIToken:  "test-token",
+		AivenAPIURL:    apiURL,
+	}, false)
+	server.SelfTest = state.MakeSelfTest()
+	return server
+}
+
+func TestGetSystemState(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	apiServer := newMockAPIServer(t, map[string]http.HandlerFunc{
+		"GET /v1/project/my-project/service/my-service": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(testServiceResponse))
+		},
+		"POST /v1/project/my-project/service/my-service/metrics": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(testMetricsResponse))
+		},
+	})
+	server := makeTestServer(apiServer.URL)
+
+	system := GetSystemState(context.Background(), server, logger)
+
+	if status := server.SelfTest.GetCollectionAspectStatus(state.CollectionAspectSystemStats); status.State != state.CollectionStateOkay {
+		t.Fatalf("want system stats okay; got %+v", status)
+	}
+	if cpu := system.CPUStats["all"].DiffedValues; cpu == nil || cpu.UserPercent != 20 || cpu.IdlePercent != 80 {
+		t.Errorf("want 20%% user / 80%% idle CPU; got %+v", cpu)
+	}
+	if system.CPUInfo.LogicalCoreCount != 2 || system.Scheduler.Loadavg1min != 1.5 {
+		t.Errorf("want 2 cores with load average 1.5; got %+v / %+v", system.CPUInfo, system.Scheduler)
+	}
+	expectedMemory := state.Memory{TotalBytes: 4096 * 1024 * 1024, ApplicationBytes: 1024 * 1024 * 1024, AvailableBytes: 2048 * 1024 * 1024}
+	if system.Memory != expectedMemory {
+		t.Errorf("want memory %+v; got %+v", expectedMemory, system.Memory)
+	}
+	expectedPartition := state.DiskPartition{DiskName: "default", UsedBytes: 8192 * 1024 * 1024, TotalBytes: 81920 * 1024 * 1024}
+	if system.DiskPartitions["/"] != expectedPartition {
+		t.Errorf("want disk partition %+v; got %+v", expectedPartition, system.DiskPartitions["/"])
+	}
+	expectedDiskStats := state.DiffedDiskStats{ReadOperationsPerSecond: 12.5, WriteOperationsPerSecond: 40}
+	if diskStats := system.DiskStats["default"].DiffedValues; diskStats == nil || *diskStats != expectedDiskStats {
+		t.Errorf("want disk stats %+v; got %+v", expectedDiskStats, diskStats)
+	}
+	expectedNetworkStats := state.DiffedNetworkStats{ReceiveThroughputBytesPerSecond: 1000, TransmitThroughputBytesPerSecond: 2000}
+	if networkStats := system.NetworkStats["default"].DiffedValues; networkStats == nil || *networkStats != expectedNetworkStats {
+		t.Errorf("want network stats %+v; got %+v", expectedNetworkStats, networkStats)
+	}
+}
+
+func TestGetSystemStateError(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	apiServer := newMockAPIServer(t, map[string]http.HandlerFunc{
+		"GET /v1/project/my-project/service/my-service": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(testServiceResponse))
+		},
+	})
+	server := makeTestServer(apiServer.URL)
+	server.Config.AivenAPIToken = "invalid-token"
+
+	GetSystemState(context.Background(), server, logger)
+
+	if status := server.SelfTest.GetCollectionAspectStatus(state.CollectionAspectSystemStats); status.State != state.CollectionStateError {
+		t.Errorf("want system stats error; got %+v", status)
+	}
+}
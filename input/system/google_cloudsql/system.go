@@ -0,0 +1,240 @@
+package google_cloudsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Cloud Monitoring samples these metrics every 60 seconds, but new data points
+// can take several minutes to become visible, so we look back further than that
+// and use the most recent point
+const (
+	metricsAlignmentPeriod = 60 * time.Second
+	metricsLookback        = 5 * time.Minute
+)
+
+type metricQuery struct {
+	metricType     string
+	resourceFilter string
+	aligner        monitoringpb.Aggregation_Aligner
+	apply          func(value float64)
+}
+
+// GetSystemState - Gets system information about a Google Cloud SQL or AlloyDB instance
+func GetSystemState(ctx context.Context, server *state.Server, logger *util.Logger) (system state.SystemState) {
+	var clientOpts []option.ClientOption
+	if server.Config.GcpCredentialsFile != "" {
+		clientOpts = append(clientOpts, option.WithCredentialsFile(server.Config.GcpCredentialsFile))
+	}
+	return getSystemState(ctx, server, logger, clientOpts)
+}
+
+func getSystemState(ctx context.Context, server *state.Server, logger *util.Logger, clientOpts []option.ClientOption) (system state.SystemState) {
+	config := server.Config
+	system.Info.Type = state.GoogleCloudSQLSystem
+
+	isAlloyDB := config.GcpAlloyDBClusterID != "" && config.GcpAlloyDBInstanceID != ""
+	if config.GcpProjectID == "" || (config.GcpCloudSQLInstanceID == "" && !isAlloyDB) {
+		server.SelfTest.MarkCollectionAspectWarning(state.CollectionAspectSystemStats, "unable to collect system stats")
+		server.SelfTest.HintCollectionAspect(state.CollectionAspectSystemStats, "Config values gcp_project_id and gcp_cloudsql_instance_id (or gcp_alloydb_cluster_id and gcp_alloydb_instance_id) are required to collect system stats.")
+		return
+	}
+
+	client, err := monitoring.NewMetricClient(ctx, clientOpts...)
+	if err != nil {
+		server.SelfTest.MarkCollectionAspectError(state.CollectionAspectSystemStats, "error making a Cloud Monitoring client: %v", err)
+		logger.PrintError("GoogleCloudSQL/System: Failed to make a Cloud Monitoring client: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	var queries []metricQuery
+	if isAlloyDB {
+		queries = alloyDBMetricQueries(config.GcpAlloyDBClusterID, config.GcpAlloyDBInstanceID, &system)
+	} else {
+		queries = cloudSQLMetricQueries(config.GcpProjectID+":"+config.GcpCloudSQLInstanceID, &system)
+	}
+
+	now := time.Now()
+	for _, query := range queries {
+		value, ok, err := getLatestMetricValue(ctx, client, config.GcpProjectID, query, now)
+		if err != nil {
+			server.SelfTest.MarkCollectionAspectError(state.CollectionAspectSystemStats, "error getting metric %s: %v", query.metricType, err)
+			server.SelfTest.HintCollectionAspect(state.CollectionAspectSystemStats, "Make sure the Monitoring Viewer role (roles/monitoring.viewer) is granted to the service account used by the collector.")
+			logger.PrintError("GoogleCloudSQL/System: Encountered error getting metric %s: %v\n", query.metricType, err)
+			return
+		}
+		if ok {
+			query.apply(value)
+		}
+	}
+
+	server.SelfTest.MarkCollectionAspectOk(state.CollectionAspectSystemStats)
+
+	return
+}
+
+func cloudSQLMetricQueries(databaseID string, system *state.SystemState) []metricQuery {
+	resourceFilter := fmt.Sprintf("resource.type = \"cloudsql_database\" AND resource.labels.database_id = \"%s\"", databaseID)
+
+	system.CPUStats = make(state.CPUStatisticMap)
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	system.Disks = make(state.DiskMap)
+	system.Disks["default"] = state.Disk{}
+	diffedDiskStats := &state.DiffedDiskStats{}
+	system.DiskStats = make(state.DiskStatsMap)
+	system.DiskStats["default"] = state.DiskStats{DiffedOnInput: true, DiffedValues: diffedDiskStats}
+	diffedNetworkStats := &state.DiffedNetworkStats{}
+	system.NetworkStats = make(state.NetworkStatsMap)
+	system.NetworkStats["default"] = state.NetworkStats{DiffedOnInput: true, DiffedValues: diffedNetworkStats}
+
+	gauge := func(metricType string, apply func(value float64)) metricQuery {
+		return metricQuery{"cloudsql.googleapis.com/database/" + metricType, resourceFilter, monitoringpb.Aggregation_ALIGN_MEAN, apply}
+	}
+	rate := func(metricType string, apply func(value float64)) metricQuery {
+		return metricQuery{"cloudsql.googleapis.com/database/" + metricType, resourceFilter, monitoringpb.Aggregation_ALIGN_RATE, apply}
+	}
+	setPartition := func(update func(partition *state.DiskPartition)) {
+		partition := system.DiskPartitions["/"]
+		partition.DiskName = "default"
+		update(&partition)
+		system.DiskPartitions["/"] = partition
+	}
+
+	return []metricQuery{
+		gauge("cpu/utilization", func(value float64) {
+			// The value is a fraction of the reserved cores (0.0 - 1.0). Report the
+			// remainder as idle, so the UI doesn't scale up the user percentage.
+			system.CPUStats["all"] = state.CPUStatistic{
+				DiffedOnInput: true,
+				DiffedValues: &state.DiffedSystemCPUStats{
+					UserPercent: value * 100,
+					IdlePercent: 100 - value*100,
+				},
+			}
+		}),
+		gauge("cpu/reserved_cores", func(value float64) {
+			system.CPUInfo.SocketCount = 1
+			system.CPUInfo.LogicalCoreCount = int32(value)
+			system.CPUInfo.PhysicalCoreCount = int32(value)
+		}),
+		gauge("memory/quota", func(value float64) {
+			system.Memory.TotalBytes = uint64(value)
+		}),
+		gauge("memory/usage", func(value float64) {
+			// Excludes the page cache, so this is the closest to "process" memory
+			system.Memory.ApplicationBytes = uint64(value)
+		}),
+		gauge("disk/quota", func(value float64) {
+			setPartition(func(partition *state.DiskPartition) { partition.TotalBytes = uint64(value) })
+		}),
+		gauge("disk/bytes_used", func(value float64) {
+			setPartition(func(partition *state.DiskPartition) { partition.UsedBytes = uint64(value) })
+		}),
+		rate("disk/read_ops_count", func(value float64) {
+			diffedDiskStats.ReadOperationsPerSecond = value
+		}),
+		rate("disk/write_ops_count", func(value float64) {
+			diffedDiskStats.WriteOperationsPerSecond = value
+		}),
+		rate("disk/read_bytes_count", func(value float64) {
+			diffedDiskStats.BytesReadPerSecond = value
+		}),
+		rate("disk/write_bytes_count", func(value float64) {
+			diffedDiskStats.BytesWrittenPerSecond = value
+		}),
+		rate("network/received_bytes_count", func(value float64) {
+			diffedNetworkStats.ReceiveThroughputBytesPerSecond = uint64(value)
+		}),
+		rate("network/sent_bytes_count", func(value float64) {
+			diffedNetworkStats.TransmitThroughputBytesPerSecond = uint64(value)
+		}),
+	}
+}
+
+// AlloyDB storage is shared by all instances of a cluster and scales
+// automatically, so there is no disk quota or per-instance disk I/O to report
+func alloyDBMetricQueries(clusterID string, instanceID string, system *state.SystemState) []metricQuery {
+	instanceFilter := fmt.Sprintf("resource.type = \"alloydb.googleapis.com/Instance\" AND resource.labels.cluster_id = \"%s\" AND resource.labels.instance_id = \"%s\"", clusterID, instanceID)
+	clusterFilter := fmt.Sprintf("resource.type = \"alloydb.googleapis.com/Cluster\" AND resource.labels.cluster_id = \"%s\"", clusterID)
+
+	system.CPUStats = make(state.CPUStatisticMap)
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	system.Disks = make(state.DiskMap)
+	system.Disks["default"] = state.Disk{}
+
+	return []metricQuery{
+		{"alloydb.googleapis.com/instance/cpu/average_utilization", instanceFilter, monitoringpb.Aggregation_ALIGN_MEAN, func(value float64) {
+			// Unlike Cloud SQL, this is already a percentage (0 - 100)
+			system.CPUStats["all"] = state.CPUStatistic{
+				DiffedOnInput: true,
+				DiffedValues: &state.DiffedSystemCPUStats{
+					UserPercent: value,
+					IdlePercent: 100 - value,
+				},
+			}
+		}},
+		{"alloydb.googleapis.com/instance/cpu/vcpus", instanceFilter, monitoringpb.Aggregation_ALIGN_MEAN, func(value float64) {
+			system.CPUInfo.SocketCount = 1
+			system.CPUInfo.LogicalCoreCount = int32(value)
+			system.CPUInfo.PhysicalCoreCount = int32(value)
+		}},
+		{"alloydb.googleapis.com/instance/memory/min_available_memory", instanceFilter, monitoringpb.Aggregation_ALIGN_MEAN, func(value float64) {
+			system.Memory.AvailableBytes = uint64(value)
+		}},
+		{"alloydb.googleapis.com/cluster/storage/usage", clusterFilter, monitoringpb.Aggregation_ALIGN_MEAN, func(value float64) {
+			system.DiskPartitions["/"] = state.DiskPartition{
+				DiskName:  "default",
+				UsedBytes: uint64(value),
+			}
+		}},
+	}
+}
+
+// getLatestMetricValue returns the most recent aligned value of the metric, or
+// false if there was no data in the lookback window
+func getLatestMetricValue(ctx context.Context, client *monitoring.MetricClient, projectID string, query metricQuery, now time.Time) (float64, bool, error) {
+	it := client.ListTimeSeries(ctx, &monitoringpb.ListTimeSeriesRequest{
+		Name:   "projects/" + projectID,
+		Filter: fmt.Sprintf("metric.type = \"%s\" AND %s", query.metricType, query.resourceFilter),
+		Interval: &monitoringpb.TimeInterval{
+			StartTime: timestamppb.New(now.Add(-metricsLookback)),
+			EndTime:   timestamppb.New(now),
+		},
+		Aggregation: &monitoringpb.Aggregation{
+			AlignmentPeriod:  durationpb.New(metricsAlignmentPeriod),
+			PerSeriesAligner: query.aligner,
+		},
+		View: monitoringpb.ListTimeSeriesRequest_FULL,
+	})
+	series, err := it.Next()
+	if err == iterator.Done {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	// Points are returned in reverse time order
+	if len(series.Points) == 0 {
+		return 0, false, nil
+	}
+	switch value := series.Points[0].Value.GetValue().(type) {
+	case *monitoringpb.TypedValue_DoubleValue:
+		return value.DoubleValue, true, nil
+	case *monitoringpb.TypedValue_Int64Value:
+		return float64(value.Int64Value), true, nil
+	}
+	return 0, false, nil
+}
@@ -0,0 +1,171 @@
+package google_cloudsql
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"regexp"
+	"testing"
+
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+type fakeMetricService struct {
+	monitoringpb.UnimplementedMetricServiceServer
+	values map[string]*monitoringpb.TypedValue
+	err    error
+}
+
+var metricTypeRegexp = regexp.MustCompile(`metric\.type = "([^"]+)"`)
+
+func (s *fakeMetricService) ListTimeSeries(ctx context.Context, req *monitoringpb.ListTimeSeriesRequest) (*monitoringpb.ListTimeSeriesResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	resp := &monitoringpb.ListTimeSeriesResponse{}
+	match := metricTypeRegexp.FindStringSubmatch(req.Filter)
+	if value, ok := s.values[match[1]]; ok && req.Name == "projects/my-project" {
+		resp.TimeSeries = append(resp.TimeSeries, &monitoringpb.TimeSeries{
+			Points: []*monitoringpb.Point{{Value: value}},
+		})
+	}
+	return resp, nil
+}
+
+func doubleValue(value float64) *monitoringpb.TypedValue {
+	return &monitoringpb.TypedValue{Value: &monitoringpb.TypedValue_DoubleValue{DoubleValue: value}}
+}
+
+func int64Value(value int64) *monitoringpb.TypedValue {
+	return &monitoringpb.TypedValue{Value: &monitoringpb.TypedValue_Int64Value{Int64Value: value}}
+}
+
+func startFakeMetricService(t *testing.T, service *fakeMetricService) []option.ClientOption {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	monitoringpb.RegisterMetricServiceServer(s, service)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	return []option.ClientOption{
+		option.WithEndpoint(lis.Addr().String()),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	}
+}
+
+func TestGetSystemStateCloudSQL(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	clientOpts := startFakeMetricService(t, &fakeMetricService{values: map[string]*monitoringpb.TypedValue{
+		"cloudsql.googleapis.com/database/cpu/utilization":              doubleValue(0.25),
+		"cloudsql.googleapis.com/database/cpu/reserved_cores":           doubleValue(4),
+		"cloudsql.googleapis.com/database/memory/quota":                 int64Value(16 * 1024 * 1024 * 1024),
+		"cloudsql.googleapis.com/database/memory/usage":                 int64Value(4 * 1024 * 1024 * 1024),
+		"cloudsql.googleapis.com/database/disk/quota":                   int64Value(100 * 1024 * 1024 * 1024),
+		"cloudsql.googleapis.com/database/disk/bytes_used":              int64Value(10 * 1024 * 1024 * 1024),
+		"cloudsql.googleapis.com/database/disk/read_ops_count":          doubleValue(120.5),
+		"cloudsql.googleapis.com/database/disk/write_ops_count":         doubleValue(80),
+		"cloudsql.googleapis.com/database/disk/read_bytes_count":        doubleValue(4096),
+		"cloudsql.googleapis.com/database/disk/write_bytes_count":       doubleValue(8192),
+		"cloudsql.googleapis.com/database/network/received_bytes_count": doubleValue(1000),
+		"cloudsql.googleapis.com/database/network/sent_bytes_count":     doubleValue(2000),
+	}})
+	server := &state.Server{
+		Config:   config.ServerConfig{GcpProjectID: "my-project", GcpCloudSQLInstanceID: "my-instance"},
+		SelfTest: state.MakeSelfTest(),
+	}
+
+	system := getSystemState(context.Background(), server, logger, clientOpts)
+
+	if status := server.SelfTest.GetCollectionAspectStatus(state.CollectionAspectSystemStats); status.State != state.CollectionStateOkay {
+		t.Fatalf("want system stats okay; got %+v", status)
+	}
+	if system.Info.Type != state.GoogleCloudSQLSystem {
+		t.Errorf("want system type %v; got %v", state.GoogleCloudSQLSystem, system.Info.Type)
+	}
+	if cpu := system.CPUStats["all"].DiffedValues; cpu == nil || cpu.UserPercent != 25 || cpu.IdlePercent != 75 {
+		t.Errorf("want 25%% user / 75%% idle CPU; got %+v", cpu)
+	}
+	if system.CPUInfo.LogicalCoreCount != 4 {
+		t.Errorf("want 4 cores; got %d", system.CPUInfo.LogicalCoreCount)
+	}
+	if system.Memory.TotalBytes != 16*1024*1024*1024 || system.Memory.ApplicationBytes != 4*1024*1024*1024 {
+		t.Errorf("want 16 GB total / 4 GB used memory; got %+v", system.Memory)
+	}
+	expectedPartition := state.DiskPartition{DiskName: "default", UsedBytes: 10 * 1024 * 1024 * 1024, TotalBytes: 100 * 1024 * 1024 * 1024}
+	if system.DiskPartitions["/"] != expectedPartition {
+		t.Errorf("want disk partition %+v; got %+v", expectedPartition, system.DiskPartitions["/"])
+	}
+	expectedDiskStats := state.DiffedDiskStats{ReadOperationsPerSecond: 120.5, WriteOperationsPerSecond: 80, BytesReadPerSecond: 4096, BytesWrittenPerSecond: 8192}
+	if diskStats := system.DiskStats["default"].DiffedValues; diskStats == nil || *diskStats != expectedDiskStats {
+		t.Errorf("want disk stats %+v; got %+v", expectedDiskStats, diskStats)
+	}
+	expectedNetworkStats := state.DiffedNetworkStats{ReceiveThroughputBytesPerSecond: 1000, TransmitThroughputBytesPerSecond: 2000}
+	if networkStats := system.NetworkStats["default"].DiffedValues; networkStats == nil || *networkStats != expectedNetworkStats {
+		t.Errorf("want network stats %+v; got %+v", expectedNetworkStats, networkStats)
+	}
+}
+
+func TestGetSystemStateAlloyDB(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	clientOpts := startFakeMetricService(t, &fakeMetricService{values: map[string]*monitoringpb.TypedValue{
+		"alloydb.googleapis.com/instance/cpu/average_utilization":     doubleValue(40),
+		"alloydb.googleapis.com/instance/cpu/vcpus":                   doubleValue(8),
+		"alloydb.googleapis.com/instance/memory/min_available_memory": int64Value(2 * 1024 * 1024 * 1024),
+		"alloydb.googleapis.com/cluster/storage/usage":                int64Value(50 * 1024 * 1024 * 1024),
+	}})
+	server := &state.Server{
+		Config:   config.ServerConfig{GcpProjectID: "my-project", GcpAlloyDBClusterID: "my-cluster", GcpAlloyDBInstanceID: "my-instance"},
+		SelfTest: state.MakeSelfTest(),
+	}
+
+	system := getSystemState(context.Background(), server, logger, clientOpts)
+
+	if status := server.SelfTest.GetCollectionAspectStatus(state.CollectionAspectSystemStats); status.State != state.CollectionStateOkay {
+		t.Fatalf("want system stats okay; got %+v", status)
+	}
+	if cpu := system.CPUStats["all"].DiffedValues; cpu == nil || cpu.UserPercent != 40 || cpu.IdlePercent != 60 {
+		t.Errorf("want 40%% user / 60%% idle CPU; got %+v", cpu)
+	}
+	if system.CPUInfo.LogicalCoreCount != 8 {
+		t.Errorf("want 8 cores; got %d", system.CPUInfo.LogicalCoreCount)
+	}
+	if system.Memory.AvailableBytes != 2*1024*1024*1024 {
+		t.Errorf("want 2 GB available memory; got %d", system.Memory.AvailableBytes)
+	}
+	if system.DiskPartitions["/"].UsedBytes != 50*1024*1024*1024 {
+		t.Errorf("want 50 GB used storage; got %d", system.DiskPartitions["/"].UsedBytes)
+	}
+	if system.DiskStats != nil || system.NetworkStats != nil {
+		t.Errorf("want no disk or network stats; got %+v / %+v", system.DiskStats, system.NetworkStats)
+	}
+}
+
+func TestGetSystemStateError(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	clientOpts := startFakeMetricService(t, &fakeMetricService{err: status.Error(codes.PermissionDenied, "permission denied")})
+	server := &state.Server{
+		Config:   config.ServerConfig{GcpProjectID: "my-project", GcpCloudSQLInstanceID: "my-instance"},
+		SelfTest: state.MakeSelfTest(),
+	}
+
+	getSystemState(context.Background(), server, logger, clientOpts)
+
+	status := server.SelfTest.GetCollectionAspectStatus(state.CollectionAspectSystemStats)
+	if status.State != state.CollectionStateError || status.Hint == "" {
+		t.Errorf("want system stats error with hint; got %+v", status)
+	}
+}
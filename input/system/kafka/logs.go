@@ -0,0 +1,205 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Only ingest log lines that were written in the last minute before startup,
+// or later (also used as the starting offset for new consumer groups, and test runs)
+const maxLogLineAge = 1 * time.Minute
+
+// SetupLogConsumer - Sets up a Kafka consumer for each server with a Kafka topic
+// configured, passing the log lines to the parsed log stream
+func SetupLogConsumer(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) error {
+	for _, server := range servers {
+		if server.Config.LogKafkaTopic == "" {
+			continue
+		}
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+
+		// Test runs read the topic without joining the consumer group, so they
+		// don't take away log lines from a collector running in the background
+		useConsumerGroup := !opts.TestRun
+		linesNewerThan := time.Now().Add(-maxLogLineAge)
+		client, err := newClient(server.Config, linesNewerThan, useConsumerGroup)
+		if err != nil {
+			if opts.TestRun {
+				return err
+			}
+
+			prefixedLogger.PrintWarning("Skipping logs, could not setup Kafka consumer: %s", err)
+			continue
+		}
+
+		if opts.TestRun {
+			prefixedLogger.PrintInfo("Setting up Kafka consumer for topic %s (without consumer group, for testing)", server.Config.LogKafkaTopic)
+		} else if opts.DebugLogs {
+			prefixedLogger.PrintInfo("Setting up Kafka consumer for topic %s (consumer group %s)", server.Config.LogKafkaTopic, server.Config.LogKafkaConsumerGroup)
+		}
+
+		wg.Add(1)
+		go func(server *state.Server) {
+			defer wg.Done()
+			defer client.Close()
+			consumeLogs(ctx, client, server, linesNewerThan, useConsumerGroup, prefixedLogger, parsedLogStream)
+		}(server)
+	}
+
+	return nil
+}
+
+func newClient(config config.ServerConfig, linesNewerThan time.Time, useConsumerGroup bool) (*kgo.Client, error) {
+	var brokers []string
+	for _, broker := range strings.Split(config.LogKafkaBrokers, ",") {
+		brokers = append(brokers, strings.TrimSpace(broker))
+	}
+
+	clientOpts := []kgo.Opt{
+		kgo.SeedBrokers(brokers...),
+		kgo.ConsumeTopics(config.LogKafkaTopic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AfterMilli(linesNewerThan.UnixMilli())),
+	}
+	if useConsumerGroup {
+		clientOpts = append(clientOpts,
+			kgo.ConsumerGroup(config.LogKafkaConsumerGroup),
+			// Offsets are committed explicitly once the log lines are handed off, and
+			// rebalances are blocked until then, to avoid processing them twice
+			kgo.DisableAutoCommit(),
+			kgo.BlockRebalanceOnPoll(),
+		)
+	}
+
+	if config.LogKafkaSaslMechanism != "" {
+		var mechanism sasl.Mechanism
+		switch config.LogKafkaSaslMechanism {
+		case "plain":
+			mechanism = plain.Auth{User: config.LogKafkaSaslUsername, Pass: config.LogKafkaSaslPassword}.AsMechanism()
+		case "scram-sha-256":
+			mechanism = scram.Auth{User: config.LogKafkaSaslUsername, Pass: config.LogKafkaSaslPassword}.AsSha256Mechanism()
+		case "scram-sha-512":
+			mechanism = scram.Auth{User: config.LogKafkaSaslUsername, Pass: config.LogKafkaSaslPassword}.AsSha512Mechanism()
+		default:
+			return nil, fmt.Errorf("unsupported SASL mechanism: %s", config.LogKafkaSaslMechanism)
+		}
+		clientOpts = append(clientOpts, kgo.SASL(mechanism))
+	}
+
+	if config.LogKafkaTLS || config.LogKafkaTLSCAFile != "" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if config.LogKafkaTLSCAFile != "" {
+			ca, err := os.ReadFile(config.LogKafkaTLSCAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read a Certificate Authority: %s", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if ok := tlsConfig.RootCAs.AppendCertsFromPEM(ca); !ok {
+				return nil, fmt.Errorf("failed to append a Certificate Authority")
+			}
+		}
+		clientOpts = append(clientOpts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	return kgo.NewClient(clientOpts...)
+}
+
+func consumeLogs(ctx context.Context, client *kgo.Client, server *state.Server, linesNewerThan time.Time, useConsumerGroup bool, logger *util.Logger, parsedLogStream chan<- state.ParsedLogStreamItem) {
+	// Leaving the consumer group when closing the client waits for rebalances
+	// to be allowed again
+	defer client.AllowRebalance()
+
+	for {
+		fetches := client.PollFetches(ctx)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			logger.PrintError("Failed to consume Kafka topic %s (partition %d): %s", topic, partition, err)
+		})
+
+		records := fetches.Records()
+		for _, record := range records {
+			logLines, err := logLinesFromRecord(record, server)
+			if err != nil {
+				logger.PrintVerbose("Could not parse Kafka message (partition %d, offset %d): %s", record.Partition, record.Offset, err)
+				continue
+			}
+			for _, logLine := range logLines {
+				// Ignore loglines which are outside our time window
+				if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
+					continue
+				}
+				select {
+				case parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		// Only commit once all log lines are handed off, so they get consumed again
+		// if the collector stops before that
+		if useConsumerGroup && len(records) > 0 {
+			err := client.CommitRecords(ctx, records...)
+			if err != nil && ctx.Err() == nil {
+				logger.PrintWarning("Failed to commit Kafka offsets: %s", err)
+			}
+		}
+		client.AllowRebalance()
+	}
+}
+
+// logLinesFromRecord - Parses a single Kafka message that contains one log
+// event, in the configured log format
+func logLinesFromRecord(record *kgo.Record, server *state.Server) ([]state.LogLine, error) {
+	parser := server.GetLogParser()
+	if parser == nil {
+		return nil, nil
+	}
+
+	content := strings.TrimRight(string(record.Value), "\n")
+	if content == "" {
+		return nil, nil
+	}
+
+	switch server.Config.LogKafkaFormat {
+	case "csvlog":
+		fields, err := logs.ParseCsvlogRecord(content)
+		if err != nil {
+			return nil, err
+		}
+		return logs.LogLinesFromStructuredRecord(fields, parser), nil
+	case "jsonlog":
+		fields, err := logs.ParseJsonlogRecord(content)
+		if err != nil {
+			return nil, err
+		}
+		return logs.LogLinesFromStructuredRecord(fields, parser), nil
+	}
+
+	// We ignore failures here since we want the per-backend stitching logic
+	// that runs later on (and any other parsing errors will just be ignored).
+	// Note that we need to restore the trailing newline since
+	// AnalyzeStreamInGroups expects it.
+	logLine, _ := parser.ParseLine(content + "\n")
+	if logLine.OccurredAt.IsZero() {
+		logLine.OccurredAt = record.Timestamp
+	}
+	return []state.LogLine{logLine}, nil
+}
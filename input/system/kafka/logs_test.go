@@ -0,0 +1,166 @@
+package kafka
+
+import (
+	"context"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const testTopic = "postgres-logs"
+
+func newTestCluster(t *testing.T) string {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(1, testTopic))
+	if err != nil {
+		t.Fatalf("could not start fake Kafka cluster: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+	return strings.Join(cluster.ListenAddrs(), ",")
+}
+
+func produce(t *testing.T, brokers string, messages ...string) {
+	client, err := kgo.NewClient(kgo.SeedBrokers(brokers), kgo.DefaultProduceTopic(testTopic))
+	if err != nil {
+		t.Fatalf("could not create producer: %v", err)
+	}
+	defer client.Close()
+	for _, message := range messages {
+		if err := client.ProduceSync(context.Background(), &kgo.Record{Value: []byte(message)}).FirstErr(); err != nil {
+			t.Fatalf("could not produce message: %v", err)
+		}
+	}
+}
+
+func makeTestServer(brokers string, format string) *state.Server {
+	server := state.MakeServer(config.ServerConfig{
+		LogKafkaBrokers:       brokers,
+		LogKafkaTopic:         testTopic,
+		LogKafkaConsumerGroup: "pganalyze-collector",
+		LogKafkaFormat:        format,
+	}, false)
+	server.LogParser = logs.NewLogParser(logs.LogPrefixAmazonRds, nil, false)
+	return server
+}
+
+// Consumes log lines until the expected number is received, and then stops the consumer
+func consume(t *testing.T, server *state.Server, opts state.CollectionOpts, expected int) []state.LogLine {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	parsedLogStream := make(chan state.ParsedLogStreamItem, state.LogStreamBufferLen)
+	err := SetupLogConsumer(ctx, &wg, opts, logger, []*state.Server{server}, parsedLogStream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var logLines []state.LogLine
+	timeout := time.After(10 * time.Second)
+	for len(logLines) < expected {
+		select {
+		case item := <-parsedLogStream:
+			logLines = append(logLines, item.LogLine)
+		case <-timeout:
+			t.Fatalf("want %d log lines; got %d before timing out", expected, len(logLines))
+		}
+	}
+	// Give the consumer a chance to commit, and make sure nothing else arrives
+	select {
+	case item := <-parsedLogStream:
+		t.Fatalf("want no additional log lines; got %+v", item.LogLine)
+	case <-time.After(500 * time.Millisecond):
+	}
+	return logLines
+}
+
+func TestSetupLogConsumer(t *testing.T) {
+	brokers := newTestCluster(t)
+	prefix := time.Now().UTC().Format("2006-01-02 15:04:05 MST") + ":10.0.0.1(5432):app@app:[101]:"
+	produce(t, brokers,
+		prefix+"ERROR:  relation \"foo\" does not exist at character 15",
+		prefix+"STATEMENT:  SELECT * FROM foo",
+	)
+
+	server := makeTestServer(brokers, "stderr")
+	logLines := consume(t, server, state.CollectionOpts{}, 2)
+	if logLines[0].LogLevel != pganalyze_collector.LogLineInformation_ERROR || logLines[0].BackendPid != 101 || logLines[0].Content != "relation \"foo\" does not exist at character 15\n" {
+		t.Errorf("want ERROR line from pid 101; got %+v", logLines[0])
+	}
+	if logLines[1].LogLevel != pganalyze_collector.LogLineInformation_STATEMENT {
+		t.Errorf("want STATEMENT line; got %+v", logLines[1])
+	}
+
+	// Offsets were committed after handing off the lines, so a restarted consumer
+	// in the same group only receives new messages
+	produce(t, brokers, prefix+"LOG:  checkpoint starting: time")
+	logLines = consume(t, server, state.CollectionOpts{}, 1)
+	if logLines[0].Content != "checkpoint starting: time\n" {
+		t.Errorf("want checkpoint line; got %+v", logLines[0])
+	}
+}
+
+func TestSetupLogConsumerTestRun(t *testing.T) {
+	brokers := newTestCluster(t)
+	prefix := time.Now().UTC().Format("2006-01-02 15:04:05 MST") + ":10.0.0.1(5432):app@app:[101]:"
+	produce(t, brokers, prefix+"LOG:  checkpoint starting: time")
+
+	// Test runs don't commit offsets for the consumer group, so the log lines
+	// are still received by the collector running in the background
+	server := makeTestServer(brokers, "stderr")
+	consume(t, server, state.CollectionOpts{TestRun: true}, 1)
+	logLines := consume(t, server, state.CollectionOpts{}, 1)
+	if logLines[0].Content != "checkpoint starting: time\n" {
+		t.Errorf("want checkpoint line; got %+v", logLines[0])
+	}
+}
+
+func TestLogLinesFromRecord(t *testing.T) {
+	now := time.Now().UTC()
+	for _, test := range []struct {
+		format  string
+		message string
+	}{
+		{"stderr", now.Format("2006-01-02 15:04:05 MST") + ":10.0.0.1(5432):app@app:[101]:LOG:  checkpoint starting: time\n"},
+		{"csvlog", now.Format("2006-01-02 15:04:05.000 MST") + `,"app","app",101,"10.0.0.1:5432",65a1b2c3.65,1,"idle",2024-01-01 00:00:00 UTC,3/0,0,LOG,00000,"checkpoint starting: time",,,,,,,,,"psql","client backend",,0`},
+		{"jsonlog", `{"timestamp":"` + now.Format("2006-01-02 15:04:05.000 MST") + `","user":"app","dbname":"app","pid":101,"error_severity":"LOG","message":"checkpoint starting: time"}`},
+	} {
+		server := makeTestServer("", test.format)
+		logLines, err := logLinesFromRecord(&kgo.Record{Value: []byte(test.message), Timestamp: now}, server)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.format, err)
+			continue
+		}
+		if len(logLines) != 1 {
+			t.Errorf("%s: want 1 log line; got %d", test.format, len(logLines))
+			continue
+		}
+		logLine := logLines[0]
+		if logLine.LogLevel != pganalyze_collector.LogLineInformation_LOG || logLine.BackendPid != 101 || logLine.Username != "app" || !strings.HasPrefix(logLine.Content, "checkpoint starting: time") {
+			t.Errorf("%s: want LOG line from pid 101; got %+v", test.format, logLine)
+		}
+		if logLine.OccurredAt.IsZero() {
+			t.Errorf("%s: want occurred at to be set", test.format)
+		}
+	}
+
+	server := makeTestServer("", "jsonlog")
+	if _, err := logLinesFromRecord(&kgo.Record{Value: []byte("not json")}, server); err == nil {
+		t.Errorf("want error for invalid jsonlog message")
+	}
+}
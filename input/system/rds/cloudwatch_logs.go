@@ -0,0 +1,148 @@
+package rds
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Limits the number of GetLogEvents calls per log stream and download, e.g. when
+// catching up after the collector was stopped for a while. Remaining events are
+// fetched on the next download.
+const maxCloudWatchLogPagesPerDownload = 50
+
+// cloudWatchLogGroupName - Log group that RDS exports Postgres logs to, with one
+// log stream per instance
+//
+// For Aurora, the log group belongs to the cluster, and contains the log streams
+// of all instances in the cluster.
+func cloudWatchLogGroupName(server *state.Server) string {
+	if server.Config.AwsDbClusterID != "" {
+		return "/aws/rds/cluster/" + server.Config.AwsDbClusterID + "/postgresql"
+	}
+	return "/aws/rds/instance/" + server.Config.AwsDbInstanceID + "/postgresql"
+}
+
+// downloadCloudWatchLogs - Gets log events for an Amazon RDS instance from the
+// log group exported to CloudWatch Logs
+func downloadCloudWatchLogs(ctx context.Context, server *state.Server, opts state.CollectionOpts, logger *util.Logger, cwlogsSvc *cloudwatchlogs.Client, identifier string) (state.PersistedLogState, []state.LogFile, []state.PostgresQuerySample, error) {
+	var psl state.PersistedLogState = server.LogPrevState
+	var logFiles []state.LogFile
+	var samples []state.PostgresQuerySample
+
+	logGroupName := cloudWatchLogGroupName(server)
+	linesNewerThan := time.Now().Add(-server.Config.LogDownloadWindow())
+	maxLogParsingSize := server.Config.MaxLogParsingSize()
+
+	// Log stream names start with the instance identifier (e.g. "mydb" or "mydb.0"
+	// after a major version upgrade)
+	var logStreams []types.LogStream
+	paginator := cloudwatchlogs.NewDescribeLogStreamsPaginator(cwlogsSvc, &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName:        aws.String(logGroupName),
+		LogStreamNamePrefix: aws.String(identifier),
+	})
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		if err != nil {
+			var notFoundErr *types.ResourceNotFoundException
+			if errors.As(err, &notFoundErr) {
+				return server.LogPrevState, nil, nil, fmt.Errorf("CloudWatch Logs log group \"%s\" not found - make sure the \"postgresql\" log export is enabled", logGroupName)
+			}
+			return server.LogPrevState, nil, nil, fmt.Errorf("Error listing CloudWatch Logs log streams: %s", err)
+		}
+		logStreams = append(logStreams, resp.LogStreams...)
+	}
+
+	var newTokens = make(map[string]string)
+
+	for _, logStream := range logStreams {
+		streamName := aws.ToString(logStream.LogStreamName)
+		if streamName != identifier && !strings.HasPrefix(streamName, identifier+".") {
+			continue
+		}
+		if logStream.LastIngestionTime != nil && time.UnixMilli(*logStream.LastIngestionTime).Before(linesNewerThan) {
+			continue
+		}
+
+		var events []string
+		var contentSize int
+		var discardedBytes int
+
+		params := &cloudwatchlogs.GetLogEventsInput{
+			LogGroupName:  aws.String(logGroupName),
+			LogStreamName: aws.String(streamName),
+			StartFromHead: aws.Bool(true),
+		}
+		if prevToken, ok := psl.AwsCloudWatchTokens[streamName]; ok {
+			params.NextToken = aws.String(prevToken)
+		} else {
+			// Without a previous token, only get events within the log download window
+			params.StartTime = aws.Int64(linesNewerThan.UnixMilli())
+		}
+
+		for page := 0; page < maxCloudWatchLogPagesPerDownload; page++ {
+			resp, err := cwlogsSvc.GetLogEvents(ctx, params)
+			if err != nil {
+				return server.LogPrevState, nil, nil, fmt.Errorf("Error getting CloudWatch Logs events: %s", err)
+			}
+			for _, event := range resp.Events {
+				message := aws.ToString(event.Message)
+				if !strings.HasSuffix(message, "\n") {
+					message += "\n"
+				}
+				events = append(events, message)
+				contentSize += len(message)
+			}
+			for contentSize > maxLogParsingSize && len(events) > 0 {
+				discardedBytes += len(events[0])
+				contentSize -= len(events[0])
+				events = events[1:]
+			}
+
+			// The same token is returned once the end of the stream is reached
+			tokenUnchanged := resp.NextForwardToken == nil || (params.NextToken != nil && *resp.NextForwardToken == *params.NextToken)
+			if resp.NextForwardToken != nil {
+				params.NextToken = resp.NextForwardToken
+				params.StartTime = nil
+			}
+			if tokenUnchanged || len(resp.Events) == 0 {
+				break
+			}
+		}
+
+		if discardedBytes > 0 {
+			logger.PrintWarning(
+				"Log data for %s exceeded the %d MB analyzed per log download, discarded %.1f MB of older data - consider lowering log_download_interval, or reducing the amount of logging",
+				streamName, maxLogParsingSize/1024/1024, float64(discardedBytes)/1024/1024)
+		}
+
+		stream := bufio.NewReader(strings.NewReader(strings.Join(events, "")))
+		newLogLines, newSamples := logs.ParseAndAnalyzeBuffer(stream, linesNewerThan, server, opts, logger)
+
+		logFile, err := state.NewLogFile(streamName)
+		if err != nil {
+			return server.LogPrevState, nil, nil, fmt.Errorf("error initializing log file: %s", err)
+		}
+		logFile.LogLines = append(logFile.LogLines, newLogLines...)
+		samples = append(samples, newSamples...)
+
+		if params.NextToken != nil {
+			newTokens[streamName] = *params.NextToken
+		}
+
+		logFiles = append(logFiles, logFile)
+	}
+	psl.AwsCloudWatchTokens = newTokens
+
+	return psl, logFiles, samples, nil
+}
@@ -0,0 +1,156 @@
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+type fakeLogStream struct {
+	name              string
+	lastIngestionTime time.Time
+	messages          []string
+}
+
+type getLogEventsRequest struct {
+	LogGroupName  string
+	LogStreamName string
+	NextToken     string
+	StartTime     int64
+}
+
+// Serves the CloudWatch Logs JSON protocol, with tokens being the index of the
+// next event in the stream, and pages of two events each
+func newFakeCloudWatchLogs(t *testing.T, logGroupName string, streams []fakeLogStream, requests *[]getLogEventsRequest) *cloudwatchlogs.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		var req getLogEventsRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.LogGroupName != logGroupName {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type": "ResourceNotFoundException", "message": "The specified log group does not exist."}`))
+			return
+		}
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "Logs_20140328.DescribeLogStreams":
+			var logStreams []map[string]any
+			for _, stream := range streams {
+				logStreams = append(logStreams, map[string]any{"logStreamName": stream.name, "lastIngestionTime": stream.lastIngestionTime.UnixMilli()})
+			}
+			json.NewEncoder(w).Encode(map[string]any{"logStreams": logStreams})
+		case "Logs_20140328.GetLogEvents":
+			*requests = append(*requests, req)
+			for _, stream := range streams {
+				if stream.name != req.LogStreamName {
+					continue
+				}
+				start := 0
+				if req.NextToken != "" {
+					start, _ = strconv.Atoi(req.NextToken)
+				}
+				end := min(start+2, len(stream.messages))
+				var events []map[string]any
+				for _, message := range stream.messages[start:end] {
+					events = append(events, map[string]any{"message": message, "timestamp": time.Now().UnixMilli()})
+				}
+				json.NewEncoder(w).Encode(map[string]any{"events": events, "nextForwardToken": strconv.Itoa(end)})
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return cloudwatchlogs.New(cloudwatchlogs.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	})
+}
+
+func TestDownloadCloudWatchLogs(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	now := time.Now().UTC()
+	prefix := now.Format("2006-01-02 15:04:05 MST") + ":10.0.0.1(5432):app@app:[101]:"
+
+	var requests []getLogEventsRequest
+	cwlogsSvc := newFakeCloudWatchLogs(t, "/aws/rds/cluster/my-cluster/postgresql", []fakeLogStream{
+		{name: "my-instance-1", lastIngestionTime: now, messages: []string{
+			prefix + "ERROR:  relation \"foo\" does not exist at character 15",
+			prefix + "STATEMENT:  SELECT * FROM foo",
+			prefix + "LOG:  checkpoint starting: time",
+		}},
+		{name: "my-instance-1.0", lastIngestionTime: now.Add(-time.Hour), messages: []string{prefix + "LOG:  stale stream"}},
+		{name: "my-instance-10", lastIngestionTime: now, messages: []string{prefix + "LOG:  other instance"}},
+	}, &requests)
+
+	server := state.MakeServer(config.ServerConfig{AwsDbClusterID: "my-cluster", LogAwsCloudwatchLogs: true}, false)
+	server.LogParser = logs.NewLogParser(logs.LogPrefixAmazonRds, nil, false)
+
+	psl, logFiles, _, err := downloadCloudWatchLogs(context.Background(), server, state.CollectionOpts{}, logger, cwlogsSvc, "my-instance-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only the recently written stream of the instance is read, until the end of the stream is reached
+	if len(requests) != 3 || requests[0].StartTime == 0 || requests[0].NextToken != "" || requests[1].NextToken != "2" || requests[2].NextToken != "3" {
+		t.Errorf("want 3 GetLogEvents requests, paginating with tokens; got %+v", requests)
+	}
+	if len(psl.AwsCloudWatchTokens) != 1 || psl.AwsCloudWatchTokens["my-instance-1"] != "3" {
+		t.Errorf("want token 3 for stream my-instance-1; got %v", psl.AwsCloudWatchTokens)
+	}
+	if len(logFiles) != 1 {
+		t.Fatalf("want 1 log file; got %d", len(logFiles))
+	}
+	logLines := logFiles[0].LogLines
+	if len(logLines) != 3 {
+		t.Fatalf("want 3 log lines; got %d", len(logLines))
+	}
+	if logLines[0].Classification != pganalyze_collector.LogLineInformation_RELATION_DOES_NOT_EXIST || logLines[0].BackendPid != 101 {
+		t.Errorf("want RELATION_DOES_NOT_EXIST line from pid 101; got %+v", logLines[0])
+	}
+	if logLines[1].ParentUUID != logLines[0].UUID {
+		t.Errorf("want STATEMENT line associated with the ERROR line; got %+v", logLines[1])
+	}
+
+	// The next download continues from the stored token
+	requests = nil
+	server.LogPrevState = psl
+	psl, logFiles, _, err = downloadCloudWatchLogs(context.Background(), server, state.CollectionOpts{}, logger, cwlogsSvc, "my-instance-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 1 || requests[0].NextToken != "3" || requests[0].StartTime != 0 {
+		t.Errorf("want 1 GetLogEvents request with token 3; got %+v", requests)
+	}
+	if len(logFiles) != 1 || len(logFiles[0].LogLines) != 0 || psl.AwsCloudWatchTokens["my-instance-1"] != "3" {
+		t.Errorf("want no new log lines and unchanged token; got %+v / %v", logFiles, psl.AwsCloudWatchTokens)
+	}
+}
+
+func TestDownloadCloudWatchLogsMissingLogGroup(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	var requests []getLogEventsRequest
+	cwlogsSvc := newFakeCloudWatchLogs(t, "/aws/rds/instance/other/postgresql", nil, &requests)
+	server := state.MakeServer(config.ServerConfig{AwsDbInstanceID: "mydb", LogAwsCloudwatchLogs: true}, false)
+
+	_, _, _, err := downloadCloudWatchLogs(context.Background(), server, state.CollectionOpts{}, logger, cwlogsSvc, "mydb")
+	expected := "CloudWatch Logs log group \"/aws/rds/instance/mydb/postgresql\" not found - make sure the \"postgresql\" log export is enabled"
+	if err == nil || err.Error() != expected {
+		t.Errorf("want error %q; got %v", expected, err)
+	}
+}
@@ -35,6 +35,10 @@ func DownloadLogFiles(ctx context.Context, server *state.Server, opts state.Coll
 		return server.LogPrevState, nil, nil, err
 	}
 
+	if server.Config.LogAwsCloudwatchLogs {
+		return downloadCloudWatchLogs(ctx, server, opts, logger, awsutil.NewCloudWatchLogsClient(awsCfg, server.Config), identifier)
+	}
+
 	// Retrieve all logfiles that may have been written to since the previous log
 	// download, based on the configured log download interval
 	linesNewerThan := time.Now().Add(-server.Config.LogDownloadWindow())
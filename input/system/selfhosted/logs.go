@@ -21,6 +21,7 @@ import (
 	"github.com/papertrail/go-tail/follower"
 	"github.com/pganalyze/collector/input/postgres"
 	"github.com/pganalyze/collector/input/system/neon"
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 )
@@ -28,6 +29,9 @@ import (
 type SelfHostedLogStreamItem struct {
 	Line string
 
+	// Optional, only used for csvlog and jsonlog files (instead of Line)
+	Record map[string]string
+
 	// Optional, only used for syslog messages
 	OccurredAt         time.Time
 	BackendPid         int32
@@ -73,10 +77,11 @@ func DiscoverLogLocation(ctx context.Context, servers []*state.Server, opts stat
 			continue
 		}
 
+		format := logFileFormatForDestination(logDestination)
 		if logDestination == "syslog" {
 			prefixedLogger.PrintInfo("WARNING: Logging via syslog - please check our setup guide for rsyslogd or syslog-ng instructions")
 			continue
-		} else if logDestination != "stderr" {
+		} else if logDestination != "stderr" && format == logFileFormatText {
 			prefixedLogger.PrintError("ERROR - Unsupported log_destination \"%s\"", logDestination)
 			continue
 		}
@@ -100,6 +105,11 @@ func DiscoverLogLocation(ctx context.Context, servers []*state.Server, opts stat
 			}
 		}
 
+		if format != logFileFormatText && loggingCollector != "on" {
+			prefixedLogger.PrintError("ERROR - log_destination \"%s\" requires logging_collector to be enabled", logDestination)
+			continue
+		}
+
 		if loggingCollector == "on" {
 			logDirectory, err := getPostgresSetting(ctx, "log_directory", server, opts, prefixedLogger)
 			if err != nil {
@@ -129,8 +139,74 @@ func SetupLogTailForServer(ctx context.Context, wg *sync.WaitGroup, opts state.C
 		logger.PrintInfo("Setting up log tail for %s", server.Config.LogLocation)
 	}
 
+	// When log_destination writes multiple formats (e.g. "stderr,csvlog"), only
+	// tail one of them to avoid processing each log event twice
+	logDestination, err := getLogDestination(ctx, server, opts, logger)
+	if err != nil {
+		return fmt.Errorf("Could not determine log_destination, not tailing log files: %s", err)
+	}
+	format := logFileFormatForDestination(logDestination)
+
 	logStream := setupLogTransformer(ctx, wg, server, opts, logger, parsedLogStream)
-	return setupLogLocationTail(ctx, server.Config.LogLocation, logStream, logger)
+	return setupLogLocationTail(ctx, server.Config.LogLocation, format, logStream, logger)
+}
+
+// Number of attempts (and the time between them) for looking up log_destination
+// when setting up a log tail, e.g. in case Postgres is still starting up
+const logDestinationAttempts = 5
+const logDestinationRetryInterval = 10 * time.Second
+
+// Test runs fail right away instead, to report the problem without delay
+func getLogDestination(ctx context.Context, server *state.Server, opts state.CollectionOpts, logger *util.Logger) (string, error) {
+	attempts := logDestinationAttempts
+	if opts.TestRun {
+		attempts = 1
+	}
+	for attempt := 1; ; attempt++ {
+		logDestination, err := getPostgresSetting(ctx, "log_destination", server, opts, logger)
+		if err == nil || attempt == attempts {
+			return logDestination, err
+		}
+		logger.PrintVerbose("Could not determine log_destination, retrying in %s: %s", logDestinationRetryInterval, err)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(logDestinationRetryInterval):
+		}
+	}
+}
+
+type logFileFormat int
+
+const (
+	logFileFormatText logFileFormat = iota // stderr output with log_line_prefix (also used for any unknown file types)
+	logFileFormatCsv
+	logFileFormatJson
+)
+
+// logFileFormatForDestination - Returns the log file format to tail for the given
+// log_destination setting, preferring the structured formats if enabled
+func logFileFormatForDestination(logDestination string) logFileFormat {
+	format := logFileFormatText
+	for _, destination := range strings.Split(logDestination, ",") {
+		switch strings.TrimSpace(destination) {
+		case "jsonlog":
+			return logFileFormatJson
+		case "csvlog":
+			format = logFileFormatCsv
+		}
+	}
+	return format
+}
+
+func logFileFormatForName(fileName string) logFileFormat {
+	switch filepath.Ext(fileName) {
+	case ".csv":
+		return logFileFormatCsv
+	case ".json":
+		return logFileFormatJson
+	}
+	return logFileFormatText
 }
 
 func SetupOtelHandlerForServers(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) {
@@ -231,8 +307,11 @@ func tailFile(ctx context.Context, path string, out chan<- SelfHostedLogStreamIt
 		return fmt.Errorf("Failed to setup log tail: %s", err)
 	}
 
+	format := logFileFormatForName(path)
+
 	go func() {
 		defer t.Close()
+		var csvRecord csvlogRecordBuffer
 	TailLoop:
 		for {
 			select {
@@ -240,8 +319,31 @@ func tailFile(ctx context.Context, path string, out chan<- SelfHostedLogStreamIt
 				if !ok {
 					break TailLoop
 				}
+				var item SelfHostedLogStreamItem
+				switch format {
+				case logFileFormatCsv:
+					data, complete := csvRecord.Append(line.String())
+					if !complete {
+						continue
+					}
+					record, err := logs.ParseCsvlogRecord(data)
+					if err != nil {
+						prefixedLogger.PrintVerbose("Could not parse csvlog record in %s: %s", path, err)
+						continue
+					}
+					item.Record = record
+				case logFileFormatJson:
+					record, err := logs.ParseJsonlogRecord(line.String())
+					if err != nil {
+						prefixedLogger.PrintVerbose("Could not parse jsonlog record in %s: %s", path, err)
+						continue
+					}
+					item.Record = record
+				default:
+					item.Line = line.String()
+				}
 				select {
-				case out <- SelfHostedLogStreamItem{Line: line.String()}:
+				case out <- item:
 				case <-ctx.Done():
 					prefixedLogger.PrintVerbose("Stopping log tail for %s (stop requested)", path)
 					break TailLoop
@@ -259,16 +361,48 @@ func tailFile(ctx context.Context, path string, out chan<- SelfHostedLogStreamIt
 	return nil
 }
 
-func isAcceptableLogFile(fileName string, fileNameFilter string) bool {
-	if fileNameFilter != "" && fileName != fileNameFilter {
-		return false
+// Limits how much data is buffered for a single csvlog record, in case of a
+// corrupted file (e.g. due to truncation) that has an unterminated quote
+const maxCsvlogRecordBytes = 10 * 1024 * 1024
+
+// csvlogRecordBuffer - Assembles csvlog records that span multiple lines, which
+// happens when a field (e.g. the query text) contains newlines
+type csvlogRecordBuffer struct {
+	data   strings.Builder
+	quotes int
+}
+
+// Append - Adds a line to the buffer, and returns the complete record once all
+// quoted fields are terminated
+//
+// Quotes within a quoted field are escaped by doubling them, so the record is
+// complete once the number of quote characters is even.
+func (b *csvlogRecordBuffer) Append(line string) (string, bool) {
+	if b.data.Len() > 0 {
+		b.data.WriteString("\n")
 	}
+	b.data.WriteString(line)
+	b.quotes += strings.Count(line, `"`)
+	if b.quotes%2 != 0 && b.data.Len() < maxCsvlogRecordBytes {
+		return "", false
+	}
+	record := b.data.String()
+	b.data.Reset()
+	b.quotes = 0
+	return record, true
+}
 
-	if strings.HasSuffix(fileName, ".gz") || strings.HasSuffix(fileName, ".bz2") || strings.HasSuffix(fileName, ".csv") {
+func isAcceptableLogFile(fileName string, fileNameFilter string, format logFileFormat) bool {
+	if fileNameFilter != "" {
+		// An explicitly specified file is always tailed, in the format indicated by its name
+		return fileName == fileNameFilter
+	}
+
+	if strings.HasSuffix(fileName, ".gz") || strings.HasSuffix(fileName, ".bz2") {
 		return false
 	}
 
-	return true
+	return logFileFormatForName(fileName) == format
 }
 
 func filterOutString(strings []string, stringToBeRemoved string) []string {
@@ -283,7 +417,7 @@ func filterOutString(strings []string, stringToBeRemoved string) []string {
 
 const maxOpenTails = 10
 
-func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+func setupLogLocationTail(ctx context.Context, logLocation string, format logFileFormat, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
 	prefixedLogger.PrintVerbose("Searching for log file(s) in %s", logLocation)
 
 	openFiles := make(map[string]context.CancelFunc)
@@ -320,7 +454,7 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 
 		fileName := path.Join(logLocation, f.Name())
 
-		if isAcceptableLogFile(fileName, fileNameFilter) {
+		if isAcceptableLogFile(fileName, fileNameFilter, format) {
 			tailCtx, tailCancel := context.WithCancel(ctx)
 			err = tailFile(tailCtx, fileName, out, prefixedLogger)
 			if err != nil {
@@ -352,7 +486,7 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 				//prefixedLogger.PrintVerbose("Received fsnotify event: %s %s", event.Op.String(), event.Name)
 				if event.Op&fsnotify.Create == fsnotify.Create || event.Op&fsnotify.Write == fsnotify.Write {
 					_, exists := openFiles[event.Name]
-					if isAcceptableLogFile(event.Name, fileNameFilter) && !exists {
+					if isAcceptableLogFile(event.Name, fileNameFilter, format) && !exists {
 						if len(openFiles) >= maxOpenTails {
 							var oldestFile string
 							oldestFile, openFilesByAge = openFilesByAge[0], openFilesByAge[1:]
@@ -458,6 +592,19 @@ func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, server *state.
 					continue
 				}
 
+				if item.Record != nil {
+					for _, logLine := range logs.LogLinesFromStructuredRecord(item.Record, logParser) {
+						if logLine.Database == "" {
+							logLine.Database = neon.LogDatabaseFallback(server.Config)
+						}
+						if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
+							continue
+						}
+						parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
+					}
+					continue
+				}
+
 				// We ignore failures here since we want the per-backend stitching logic
 				// that runs later on (and any other parsing errors will just be ignored)
 				// Note that we need to restore the original trailing newlines since
@@ -138,6 +138,94 @@ func TestFollower_Close_NoDeadlock_SendLineBlocks(t *testing.T) {
 	}
 }
 
+// Verifies that csvlog records spanning multiple lines (due to newlines in quoted
+// fields) are emitted as a single parsed record.
+func TestTailFile_Csvlog(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "postgresql.csv")
+
+	if err := os.WriteFile(logPath, nil, 0644); err != nil {
+		t.Fatalf("create file: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	linesCh := make(chan SelfHostedLogStreamItem, 10)
+	if err := tailFile(ctx, logPath, linesCh, testLogger()); err != nil {
+		t.Fatalf("tailFile: %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	data := "2024-05-01 10:11:12.345 UTC,\"app\",\"db\",1234,,1.2,1,,,,0,LOG,00000,\"duration: 1.000 ms  statement: SELECT\n  \"\"quoted\"\"\n  FROM t\",,,,,,,,,\"psql\"\n" +
+		"2024-05-01 10:11:13.345 UTC,\"app\",\"db\",1234,,1.2,2,,,,0,LOG,00000,\"second\",,,,,,,,,\"psql\"\n"
+	if err := os.WriteFile(logPath, []byte(data), 0644); err != nil {
+		t.Fatalf("append lines: %v", err)
+	}
+
+	var records []map[string]string
+	timer := time.NewTimer(2 * time.Second)
+	defer timer.Stop()
+	for len(records) < 2 {
+		select {
+		case item := <-linesCh:
+			records = append(records, item.Record)
+		case <-timer.C:
+			t.Fatalf("expected 2 records, got: %v", records)
+		}
+	}
+	if records[0]["message"] != "duration: 1.000 ms  statement: SELECT\n  \"quoted\"\n  FROM t" {
+		t.Errorf("unexpected first message: %q", records[0]["message"])
+	}
+	if records[1]["message"] != "second" || records[1]["session_line_num"] != "2" {
+		t.Errorf("unexpected second record: %v", records[1])
+	}
+}
+
+func TestIsAcceptableLogFile(t *testing.T) {
+	tests := []struct {
+		fileName       string
+		fileNameFilter string
+		format         logFileFormat
+		expected       bool
+	}{
+		{"/var/log/postgresql/postgresql-Mon.log", "", logFileFormatText, true},
+		{"/var/log/postgresql/postgresql-Mon.csv", "", logFileFormatText, false},
+		{"/var/log/postgresql/postgresql-Mon.csv", "", logFileFormatCsv, true},
+		{"/var/log/postgresql/postgresql-Mon.log", "", logFileFormatCsv, false},
+		{"/var/log/postgresql/postgresql-Mon.json", "", logFileFormatJson, true},
+		{"/var/log/postgresql/postgresql-Mon.json.gz", "", logFileFormatJson, false},
+		{"/var/log/postgresql/postgresql-Mon.log.gz", "", logFileFormatText, false},
+		{"/var/log/postgresql/postgresql.csv", "/var/log/postgresql/postgresql.csv", logFileFormatText, true},
+		{"/var/log/postgresql/other.log", "/var/log/postgresql/postgresql.csv", logFileFormatText, false},
+	}
+	for _, test := range tests {
+		actual := isAcceptableLogFile(test.fileName, test.fileNameFilter, test.format)
+		if actual != test.expected {
+			t.Errorf("isAcceptableLogFile(%q, %q, %d): want %v; got %v", test.fileName, test.fileNameFilter, test.format, test.expected, actual)
+		}
+	}
+}
+
+func TestLogFileFormatForDestination(t *testing.T) {
+	tests := []struct {
+		logDestination string
+		expected       logFileFormat
+	}{
+		{"stderr", logFileFormatText},
+		{"csvlog", logFileFormatCsv},
+		{"stderr,csvlog", logFileFormatCsv},
+		{"stderr, jsonlog", logFileFormatJson},
+		{"csvlog,jsonlog", logFileFormatJson},
+		{"syslog", logFileFormatText},
+	}
+	for _, test := range tests {
+		actual := logFileFormatForDestination(test.logDestination)
+		if actual != test.expected {
+			t.Errorf("logFileFormatForDestination(%q): want %d; got %d", test.logDestination, test.expected, actual)
+		}
+	}
+}
+
 func testLogger() *util.Logger {
 	return &util.Logger{
 		Verbose:     true,
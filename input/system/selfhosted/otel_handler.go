@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -15,7 +14,7 @@ import (
 
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/input/system/supabase"
-	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 	otlpLogsService "go.opentelemetry.io/proto/otlp/collector/logs/v1"
@@ -188,11 +187,10 @@ func handleOtlpLogsRequest(logsData *otlpLogs.LogsData, servers []*state.Server,
 					if hasSupabaseServer {
 						if parsed := supabase.ParsedFields(kv); parsed != nil {
 							warnAboutMultipleServers(servers, warnedAboutMultipleServers, prefixedLogger)
-							logLine, detailLine := logLineFromStructuredFields(parsed, nil, l)
+							logLines := logLinesFromStructuredFields(parsed, nil, l)
 							for _, server := range servers {
-								parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
-								if detailLine != nil {
-									parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: *detailLine}
+								for _, logLine := range logLines {
+									parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
 								}
 							}
 							continue
@@ -208,10 +206,8 @@ func handleOtlpLogsRequest(logsData *otlpLogs.LogsData, servers []*state.Server,
 									continue
 								}
 								logParser := server.GetLogParser()
-								logLine, detailLine := logLineFromStructuredFields(record, logParser, nil)
-								parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
-								if detailLine != nil {
-									parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: *detailLine}
+								for _, logLine := range logLinesFromStructuredFields(record, logParser, nil) {
+									parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
 								}
 							}
 						} else {
@@ -219,10 +215,8 @@ func handleOtlpLogsRequest(logsData *otlpLogs.LogsData, servers []*state.Server,
 							warnAboutMultipleServers(servers, warnedAboutMultipleServers, prefixedLogger)
 							for _, server := range servers {
 								logParser := server.GetLogParser()
-								logLine, detailLine := logLineFromStructuredFields(record, logParser, nil)
-								parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
-								if detailLine != nil {
-									parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: *detailLine}
+								for _, logLine := range logLinesFromStructuredFields(record, logParser, nil) {
+									parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
 								}
 							}
 						}
@@ -300,72 +294,38 @@ func warnAboutMultipleServers(servers []*state.Server, warnedAboutMultipleServer
 	*warnedAboutMultipleServers = true
 }
 
-// logLineFromStructuredFields maps Postgres structured log fields (an OTel key/value
-// list) to a LogLine. Postgres's jsonlog and csvlog formats share these field names:
-// OTel pipelines deliver jsonlog records directly, while Supabase's log drain delivers
-// csvlog-derived fields (metadata.parsed) with the message and timestamp carried on the
-// OTel record itself — pass l there so EventName and TimeUnixNano fill those in.
-func logLineFromStructuredFields(record *common.KeyValueList, logParser state.LogParser, l *otlpLogs.LogRecord) (state.LogLine, *state.LogLine) {
-	var logLine state.LogLine
-	if l != nil {
-		logLine.Content = l.EventName
-		logLine.OccurredAt = time.Unix(0, int64(l.TimeUnixNano))
+// logLinesFromStructuredFields maps Postgres structured log fields (an OTel key/value
+// list) to log lines, using the same mapping as csvlog/jsonlog files. Postgres's jsonlog
+// and csvlog formats share these field names: OTel pipelines deliver jsonlog records
+// directly, while Supabase's log drain delivers csvlog-derived fields (metadata.parsed)
+// with the message and timestamp carried on the OTel record itself — pass l there so
+// EventName and TimeUnixNano fill those in.
+func logLinesFromStructuredFields(record *common.KeyValueList, logParser state.LogParser, l *otlpLogs.LogRecord) []state.LogLine {
+	fields := make(map[string]string, len(record.Values))
+	for _, rv := range record.Values {
+		fields[rv.Key] = anyValueString(rv.Value)
+	}
+	if l != nil && l.EventName != "" {
+		fields["message"] = l.EventName
 	}
 
-	// If a DETAIL line is set, we need to create an additional log line
-	detailLineContent := ""
-
-	for _, rv := range record.Values {
-		if rv.Key == "log_time" && logLine.OccurredAt.IsZero() && logParser != nil {
-			logLine.OccurredAt = logParser.GetOccurredAt(rv.Value.GetStringValue())
-		}
-		if rv.Key == "user_name" {
-			logLine.Username = rv.Value.GetStringValue()
-		}
-		if rv.Key == "database_name" {
-			logLine.Database = rv.Value.GetStringValue()
-		}
-		if rv.Key == "process_id" {
-			logLine.BackendPid = anyValueInt(rv.Value)
-		}
-		if rv.Key == "application_name" {
-			logLine.Application = rv.Value.GetStringValue()
-		}
-		if rv.Key == "session_line_num" {
-			logLine.LogLineNumber = anyValueInt(rv.Value)
-		}
-		if rv.Key == "message" && logLine.Content == "" {
-			logLine.Content = rv.Value.GetStringValue()
-		}
-		if rv.Key == "detail" {
-			detailLineContent = rv.Value.GetStringValue()
-		}
-		if rv.Key == "error_severity" {
-			logLine.LogLevel = pganalyze_collector.LogLineInformation_LogLevel(pganalyze_collector.LogLineInformation_LogLevel_value[rv.Value.GetStringValue()])
+	logLines := logs.LogLinesFromStructuredRecord(fields, logParser)
+	if l != nil {
+		occurredAt := time.Unix(0, int64(l.TimeUnixNano))
+		for idx := range logLines {
+			logLines[idx].OccurredAt = occurredAt
 		}
 	}
-	if detailLineContent != "" {
-		detailLine := logLine
-		detailLine.Content = detailLineContent
-		detailLine.LogLevel = pganalyze_collector.LogLineInformation_DETAIL
-		return logLine, &detailLine
-	}
-	return logLine, nil
+	return logLines
 }
 
-// anyValueInt reads a bounds-checked int32 from an OTel value that may be encoded as an
-// int (Supabase's drain sends process_id and session_line_num as ints) or a numeric
-// string (jsonlog).
-func anyValueInt(v *common.AnyValue) int32 {
-	if s := v.GetStringValue(); s != "" {
-		n, _ := strconv.ParseInt(s, 10, 32)
-		return int32(n)
-	}
-	n := v.GetIntValue()
-	if n < math.MinInt32 || n > math.MaxInt32 {
-		return 0
+// anyValueString reads an OTel value that may be encoded as an int (Supabase's drain
+// sends process_id and session_line_num as ints) or a string (jsonlog).
+func anyValueString(v *common.AnyValue) string {
+	if _, ok := v.Value.(*common.AnyValue_IntValue); ok {
+		return strconv.FormatInt(v.GetIntValue(), 10)
 	}
-	return int32(n)
+	return v.GetStringValue()
 }
 
 func skipDueToK8sFilter(kubernetes *common.KeyValueList, config config.ServerConfig) bool {
@@ -47,7 +47,7 @@ func TestOtelHandlerProtobuf(t *testing.T) {
 			expectRawItems:    0,
 			expectParsedItems: 1,
 			checkParsed: func(t *testing.T, items []state.ParsedLogStreamItem) {
-				if items[0].LogLine.Content != "database system is ready to accept connections" {
+				if items[0].LogLine.Content != "database system is ready to accept connections\n" {
 					t.Errorf("unexpected content: %s", items[0].LogLine.Content)
 				}
 				if items[0].LogLine.Username != "postgres" {
@@ -70,13 +70,13 @@ func TestOtelHandlerProtobuf(t *testing.T) {
 			expectRawItems:    0,
 			expectParsedItems: 2,
 			checkParsed: func(t *testing.T, items []state.ParsedLogStreamItem) {
-				if items[0].LogLine.Content != "relation \"missing\" does not exist" {
+				if items[0].LogLine.Content != "relation \"missing\" does not exist\n" {
 					t.Errorf("unexpected content: %s", items[0].LogLine.Content)
 				}
 				if items[0].LogLine.LogLevel != pganalyze_collector.LogLineInformation_ERROR {
 					t.Errorf("unexpected log level: %v", items[0].LogLine.LogLevel)
 				}
-				if items[1].LogLine.Content != "some detail" {
+				if items[1].LogLine.Content != "some detail\n" {
 					t.Errorf("unexpected detail content: %s", items[1].LogLine.Content)
 				}
 				if items[1].LogLine.LogLevel != pganalyze_collector.LogLineInformation_DETAIL {
@@ -93,7 +93,7 @@ func TestOtelHandlerProtobuf(t *testing.T) {
 			checkParsed: func(t *testing.T, items []state.ParsedLogStreamItem) {
 				// Routing proof: the record reaches the parsed stream with its message
 				// (from EventName). Field-level mapping is covered by supabase.TestLogLineFrom.
-				if items[0].LogLine.Content != supabaseAutoExplainMessage {
+				if items[0].LogLine.Content != supabaseAutoExplainMessage+"\n" {
 					t.Errorf("unexpected content: %s", items[0].LogLine.Content)
 				}
 			},
@@ -312,7 +312,7 @@ func TestOtelHandlerHTTPEndpoint(t *testing.T) {
 	}
 }
 
-func TestLogLineFromJsonlog(t *testing.T) {
+func TestLogLinesFromStructuredFields(t *testing.T) {
 	t.Run("supabase record: message/timestamp from the record, int-encoded fields", func(t *testing.T) {
 		parsed := &common.KeyValueList{Values: []*common.KeyValue{
 			otelKV("user_name", "pganalyze"),
@@ -324,15 +324,16 @@ func TestLogLineFromJsonlog(t *testing.T) {
 		}}
 		l := &otlpLogs.LogRecord{EventName: "duration: 3003.075 ms  plan: {...}", TimeUnixNano: uint64(testTimestamp.UnixNano())}
 
-		logLine, detail := logLineFromStructuredFields(parsed, nil, l)
-		if detail != nil {
-			t.Errorf("unexpected detail line: %+v", detail)
+		logLines := logLinesFromStructuredFields(parsed, nil, l)
+		if len(logLines) != 1 {
+			t.Fatalf("want 1 line; got %+v", logLines)
 		}
-		if logLine.Content != l.EventName {
+		logLine := logLines[0]
+		if logLine.Content != l.EventName+"\n" {
 			t.Errorf("Content = %q, want the record's EventName", logLine.Content)
 		}
-		if logLine.OccurredAt.IsZero() {
-			t.Error("OccurredAt should come from TimeUnixNano")
+		if !logLine.OccurredAt.Equal(testTimestamp) {
+			t.Errorf("OccurredAt = %s, want it to come from TimeUnixNano", logLine.OccurredAt)
 		}
 		if logLine.BackendPid != 60649 {
 			t.Errorf("BackendPid = %d", logLine.BackendPid)
@@ -350,29 +351,38 @@ func TestLogLineFromJsonlog(t *testing.T) {
 
 	t.Run("out-of-range int dropped to 0", func(t *testing.T) {
 		parsed := &common.KeyValueList{Values: []*common.KeyValue{otelIntKV("process_id", 1<<40)}}
-		logLine, _ := logLineFromStructuredFields(parsed, nil, &otlpLogs.LogRecord{})
-		if logLine.BackendPid != 0 {
-			t.Errorf("BackendPid = %d, want 0 for out-of-range value", logLine.BackendPid)
+		logLines := logLinesFromStructuredFields(parsed, nil, &otlpLogs.LogRecord{})
+		if logLines[0].BackendPid != 0 {
+			t.Errorf("BackendPid = %d, want 0 for out-of-range value", logLines[0].BackendPid)
 		}
 	})
 
-	t.Run("detail becomes a second line", func(t *testing.T) {
+	t.Run("detail, hint, context and statement become additional lines", func(t *testing.T) {
 		parsed := &common.KeyValueList{Values: []*common.KeyValue{
 			otelKV("error_severity", "ERROR"),
 			otelKV("detail", "Key (id)=(1) already exists."),
+			otelKV("hint", "Use a different id."),
+			otelKV("context", "PL/pgSQL function insert_item() line 3 at SQL statement"),
+			otelKV("query", "INSERT INTO items VALUES (1)"),
 		}}
-		logLine, detail := logLineFromStructuredFields(parsed, nil, &otlpLogs.LogRecord{EventName: "duplicate key value"})
-		if logLine.Content != "duplicate key value" {
-			t.Errorf("primary Content = %q", logLine.Content)
+		logLines := logLinesFromStructuredFields(parsed, nil, &otlpLogs.LogRecord{EventName: "duplicate key value"})
+		expected := []struct {
+			content  string
+			logLevel pganalyze_collector.LogLineInformation_LogLevel
+		}{
+			{"duplicate key value\n", pganalyze_collector.LogLineInformation_ERROR},
+			{"Key (id)=(1) already exists.\n", pganalyze_collector.LogLineInformation_DETAIL},
+			{"Use a different id.\n", pganalyze_collector.LogLineInformation_HINT},
+			{"PL/pgSQL function insert_item() line 3 at SQL statement\n", pganalyze_collector.LogLineInformation_CONTEXT},
+			{"INSERT INTO items VALUES (1)\n", pganalyze_collector.LogLineInformation_STATEMENT},
 		}
-		if detail == nil {
-			t.Fatal("expected a detail line")
+		if len(logLines) != len(expected) {
+			t.Fatalf("want %d lines; got %+v", len(expected), logLines)
 		}
-		if detail.Content != "Key (id)=(1) already exists." {
-			t.Errorf("detail Content = %q", detail.Content)
-		}
-		if detail.LogLevel != pganalyze_collector.LogLineInformation_DETAIL {
-			t.Errorf("detail LogLevel = %v, want DETAIL", detail.LogLevel)
+		for idx, e := range expected {
+			if logLines[idx].Content != e.content || logLines[idx].LogLevel != e.logLevel {
+				t.Errorf("line %d: want %v %q; got %v %q", idx, e.logLevel, e.content, logLines[idx].LogLevel, logLines[idx].Content)
+			}
 		}
 	})
 
@@ -382,12 +392,12 @@ func TestLogLineFromJsonlog(t *testing.T) {
 			otelKV("process_id", "123"),
 			otelKV("error_severity", "LOG"),
 		}}
-		logLine, _ := logLineFromStructuredFields(record, nil, nil)
-		if logLine.Content != "database system is ready to accept connections" {
-			t.Errorf("Content = %q", logLine.Content)
+		logLines := logLinesFromStructuredFields(record, nil, nil)
+		if logLines[0].Content != "database system is ready to accept connections\n" {
+			t.Errorf("Content = %q", logLines[0].Content)
 		}
-		if logLine.BackendPid != 123 {
-			t.Errorf("BackendPid = %d, want 123", logLine.BackendPid)
+		if logLines[0].BackendPid != 123 {
+			t.Errorf("BackendPid = %d, want 123", logLines[0].BackendPid)
 		}
 	})
 }
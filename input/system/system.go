@@ -3,12 +3,14 @@ package system
 import (
 	"context"
 
+	"github.com/pganalyze/collector/input/system/aiven"
 	"github.com/pganalyze/collector/input/system/azure"
 	"github.com/pganalyze/collector/input/system/planetscale"
 	"github.com/pganalyze/collector/input/system/tembo"
 
 	"github.com/pganalyze/collector/input/postgres"
 	"github.com/pganalyze/collector/input/system/crunchy_bridge"
+	"github.com/pganalyze/collector/input/system/google_cloudsql"
 	"github.com/pganalyze/collector/input/system/rds"
 	"github.com/pganalyze/collector/input/system/selfhosted"
 	"github.com/pganalyze/collector/state"
@@ -27,6 +29,11 @@ func DownloadLogFiles(ctx context.Context, server *state.Server, opts state.Coll
 		if err != nil {
 			return
 		}
+	} else if server.Config.SupportsAivenLogs() {
+		psl, files, querySamples, err = aiven.DownloadLogFiles(ctx, server, opts, logger)
+		if err != nil {
+			return
+		}
 	} else if server.Config.LogPgReadFile {
 		psl, files, querySamples, err = postgres.LogPgReadFile(ctx, server, opts, logger)
 		if err != nil {
@@ -46,8 +53,7 @@ func GetSystemState(ctx context.Context, server *state.Server, logger *util.Logg
 	if config.SystemType == "amazon_rds" {
 		system = rds.GetSystemState(ctx, server, logger)
 	} else if config.SystemType == "google_cloudsql" {
-		system.Info.Type = state.GoogleCloudSQLSystem
-		server.SelfTest.MarkCollectionAspectNotAvailable(state.CollectionAspectSystemStats, "not available on this platform")
+		system = google_cloudsql.GetSystemState(ctx, server, logger)
 	} else if config.SystemType == "azure_database" {
 		system = azure.GetSystemState(ctx, server, logger)
 	} else if config.SystemType == "heroku" {
@@ -56,8 +62,7 @@ func GetSystemState(ctx context.Context, server *state.Server, logger *util.Logg
 	} else if config.SystemType == "crunchy_bridge" {
 		system = crunchy_bridge.GetSystemState(ctx, server, logger)
 	} else if config.SystemType == "aiven" {
-		system.Info.Type = state.AivenSystem
-		server.SelfTest.MarkCollectionAspectNotAvailable(state.CollectionAspectSystemStats, "not available on this platform")
+		system = aiven.GetSystemState(ctx, server, logger)
 	} else if config.SystemType == "tembo" {
 		system = tembo.GetSystemState(ctx, server, logger)
 	} else if config.SystemType == "planetscale" {
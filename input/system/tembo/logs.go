@@ -5,13 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
-	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 )
@@ -148,14 +147,12 @@ func setupWebsocketForServer(ctx context.Context, wg *sync.WaitGroup, opts state
 			}
 			for _, stream := range result.Streams {
 				for _, values := range stream.Values {
-					logLine, detailLine := logLineFromJsonlog(values[1], logParser, logger)
-					// Ignore loglines which are outside our time window
-					if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
-						continue
-					}
-					parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
-					if detailLine != nil {
-						parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: *detailLine}
+					for _, logLine := range logLinesFromJsonlog(values[1], logParser, logger) {
+						// Ignore loglines which are outside our time window
+						if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
+							continue
+						}
+						parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
 					}
 				}
 			}
@@ -163,56 +160,14 @@ func setupWebsocketForServer(ctx context.Context, wg *sync.WaitGroup, opts state
 	}()
 }
 
-func logLineFromJsonlog(recordIn string, logParser state.LogParser, logger *util.Logger) (state.LogLine, *state.LogLine) {
+func logLinesFromJsonlog(recordIn string, logParser state.LogParser, logger *util.Logger) []state.LogLine {
 	var event JSONLogEvent
-	var logLine state.LogLine
 
 	err := json.Unmarshal([]byte(recordIn), &event)
 	if err != nil {
 		logger.PrintError("Error unmarshalling JSON: %s", err)
-		return logLine, nil
-	}
-
-	// If a DETAIL line is set, we need to create an additional log line
-	detailLineContent := ""
-
-	for key, value := range event.Record {
-		if key == "log_time" {
-			logLine.OccurredAt = logParser.GetOccurredAt(value)
-		}
-		if key == "user_name" {
-			logLine.Username = value
-		}
-		if key == "database_name" {
-			logLine.Database = value
-		}
-		if key == "process_id" {
-			backendPid, _ := strconv.ParseInt(value, 10, 32)
-			logLine.BackendPid = int32(backendPid)
-		}
-		if key == "application_name" {
-			logLine.Application = value
-		}
-		if key == "session_line_num" {
-			logLineNumber, _ := strconv.ParseInt(value, 10, 32)
-			logLine.LogLineNumber = int32(logLineNumber)
-		}
-		if key == "message" {
-			logLine.Content = value
-		}
-		if key == "detail" {
-			detailLineContent = value
-		}
-		if key == "error_severity" {
-			logLine.LogLevel = pganalyze_collector.LogLineInformation_LogLevel(pganalyze_collector.LogLineInformation_LogLevel_value[value])
-		}
-	}
-	if detailLineContent != "" {
-		detailLine := logLine
-		detailLine.Content = detailLineContent
-		detailLine.LogLevel = pganalyze_collector.LogLineInformation_DETAIL
-		return logLine, &detailLine
+		return nil
 	}
 
-	return logLine, nil
+	return logs.LogLinesFromStructuredRecord(event.Record, logParser)
 }
@@ -0,0 +1,107 @@
+package tembo
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+type jsonlogTestpair struct {
+	record string
+	output []state.LogLine
+}
+
+var jsonlogTests = []jsonlogTestpair{
+	{
+		// Error with all additional fields set, each of which results in its own log line
+		`{"record":{"log_time":"2024-05-01 10:11:12.345 UTC","user_name":"app_user","database_name":"app_db","process_id":"1234","session_line_num":"7","application_name":"psql","error_severity":"ERROR","message":"division by zero","detail":"Some detail","hint":"Some hint","context":"PL/pgSQL function inline_code_block line 1 at RAISE","query":"SELECT 1/0"}}`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_ERROR,
+				Content:       "division by zero\n",
+			},
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_DETAIL,
+				Content:       "Some detail\n",
+			},
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_HINT,
+				Content:       "Some hint\n",
+			},
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_CONTEXT,
+				Content:       "PL/pgSQL function inline_code_block line 1 at RAISE\n",
+			},
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_STATEMENT,
+				Content:       "SELECT 1/0\n",
+			},
+		},
+	},
+	{
+		// Background process without any additional fields
+		`{"record":{"log_time":"2024-05-01 10:11:12.000 UTC","user_name":"","database_name":"","process_id":"99","session_line_num":"1","application_name":"","error_severity":"LOG","message":"checkpoint starting: time"}}`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 0, time.UTC),
+				BackendPid:    99,
+				LogLineNumber: 1,
+				LogLevel:      pganalyze_collector.LogLineInformation_LOG,
+				Content:       "checkpoint starting: time\n",
+			},
+		},
+	},
+	{
+		// Invalid JSON is skipped
+		`{"record":`,
+		nil,
+	},
+}
+
+func TestLogLinesFromJsonlog(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	logParser := logs.NewLogParser(logs.LogPrefixEmpty, nil, false)
+	for _, pair := range jsonlogTests {
+		logLines := logLinesFromJsonlog(pair.record, logParser, logger)
+		if diff := pretty.Compare(pair.output, logLines); diff != "" {
+			t.Errorf("For %s: log lines diff: (-want +got)\n%s", pair.record, diff)
+		}
+	}
+}
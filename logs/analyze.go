@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/pganalyze/collector/logs/querysample"
 	"github.com/pganalyze/collector/logs/util"
@@ -281,6 +283,18 @@ var connectionTerminated = analyzeGroup{
 		prefixes: []string{"terminating connection due to administrator command"},
 	},
 }
+var connectionIdleInTransactionTimeout = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_CONNECTION_IDLE_IN_TRANSACTION_TIMEOUT,
+	primary: match{
+		prefixes: []string{"terminating connection due to idle-in-transaction timeout"},
+	},
+}
+var connectionIdleSessionTimeout = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_CONNECTION_IDLE_SESSION_TIMEOUT,
+	primary: match{
+		prefixes: []string{"terminating connection due to idle-session timeout"},
+	},
+}
 var outOfConnections = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS,
 	primary: match{
@@ -646,6 +660,29 @@ var statsCollectorTimeout = analyzeGroup{
 		},
 	},
 }
+var serverDiskFull = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_DISK_FULL,
+	primary: match{
+		prefixes: []string{"could not extend file", "could not write to file", "could not write to log file", "could not write block"},
+		regexp:   regexp.MustCompile(`^could not (?:extend file "([^"\n]+)"(?: with File\w+\(\))?|write (?:to file|block \d+ in file) "([^"\n]+)"|write to log file "?([\w./-]+)"? at offset \d+, length \d+): (?:No space left on device|wrote only \d+ of \d+ bytes at block \d+)`),
+		secrets:  []state.LogSecretKind{0, 0, 0},
+	},
+	hint: match{
+		prefixes: []string{"Check free disk space."},
+	},
+}
+var serverOutOfSharedMemory = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_OUT_OF_SHARED_MEMORY,
+	primary: match{
+		prefixes: []string{"out of shared memory", "could not resize shared memory segment"},
+		regexp:   regexp.MustCompile(`^(?:out of shared memory(?: \((\d+) bytes requested\))?|could not resize shared memory segment "([^"\n]+)" to (\d+) bytes: No space left on device)`),
+		secrets:  []state.LogSecretKind{0, 0, 0},
+	},
+	hint: match{
+		regexp:  regexp.MustCompile(`^You might need to increase "?(max_locks_per_transaction|max_pred_locks_per_transaction)"?\.`),
+		secrets: []state.LogSecretKind{0},
+	},
+}
 var standbyRestoredLogFile = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_STANDBY_RESTORED_WAL_FROM_ARCHIVE,
 	primary: match{
@@ -701,6 +738,68 @@ var regexpInvalidTimeline = analyzeGroup{
 		secrets:  []state.LogSecretKind{},
 	},
 }
+var replicationTimeout = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_REPLICATION_TIMEOUT,
+	primary: match{
+		prefixes: []string{
+			"terminating walsender process due to replication timeout",
+			"terminating walreceiver due to timeout",
+			"terminating logical replication worker due to timeout",
+		},
+		regexp:  regexp.MustCompile(`^terminating (walsender process|walreceiver|logical replication worker) due to (?:replication )?timeout`),
+		secrets: []state.LogSecretKind{0},
+	},
+}
+var replicationSlotInvalidated = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_REPLICATION_SLOT_INVALIDATED,
+	primary: match{
+		prefixes: []string{"invalidating obsolete replication slot", "invalidating slot", "terminating process"},
+		regexp:   regexp.MustCompile(`^(?:invalidating obsolete replication slot "([^"\n]+)"|invalidating slot "([^"\n]+)" because its restart_lsn (\w+/\w+) exceeds max_slot_wal_keep_size|terminating process (\d+) to release replication slot "([^"\n]+)")`),
+		secrets:  []state.LogSecretKind{0, 0, 0, 0, 0},
+	},
+	detail: match{
+		regexp:  regexp.MustCompile(`^(?:The slot's restart_lsn (\w+/\w+) exceeds the limit by (\d+) bytes|The slot conflicted with xid horizon (\d+)|The slot's idle time of \d+min \d+s exceeds the configured "?idle_replication_slot_timeout"? duration of \d+min|(Logical decoding on standby requires "?wal_level"? >= "?logical"? on the primary server))\.`),
+		secrets: []state.LogSecretKind{0, 0, 0, 0},
+	},
+	hint: match{
+		prefixes: []string{
+			"You might need to increase max_slot_wal_keep_size.",
+			"You might need to increase \"max_slot_wal_keep_size\".",
+		},
+	},
+}
+var logicalReplicationTargetRelation = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+	primary: match{
+		prefixes: []string{"logical replication target relation"},
+		regexp:   regexp.MustCompile(`^logical replication target relation "([^"\n]+)" (?:does not exist|is missing (?:some )?replicated columns?(?:\(s\))?|uses system columns in REPLICA IDENTITY index|has neither REPLICA IDENTITY index nor PRIMARY KEY and published relation does not have REPLICA IDENTITY FULL)`),
+		secrets:  []state.LogSecretKind{0},
+	},
+}
+var logicalReplicationSubscriptionDisabled = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+	primary: match{
+		prefixes: []string{"subscription"},
+		regexp:   regexp.MustCompile(`^subscription "([^"\n]+)" has been disabled because of an error`),
+		secrets:  []state.LogSecretKind{0},
+	},
+}
+var logicalReplicationPublisherConnection = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+	primary: match{
+		prefixes: []string{
+			"could not connect to the publisher: ",
+			"apply worker for subscription",
+			"table synchronization worker for subscription",
+			"logical replication apply worker for subscription",
+			"logical replication table synchronization worker for subscription",
+			"logical replication parallel apply worker for subscription",
+		},
+		regexp:        regexp.MustCompile(`^(?:(?:logical replication )?(?:apply|table synchronization|parallel apply) worker for subscription "([^"\n]+)" )?could not connect to the publisher: `),
+		secrets:       []state.LogSecretKind{0},
+		remainderKind: state.OpsLogSecret,
+	},
+}
 var uniqueConstraintViolation = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
 	primary: match{
@@ -1568,28 +1667,72 @@ var otherContextPatterns = []match{
 		regexp:   regexp.MustCompile(`(?:(?:unnamed portal|portal \"(.+)\") with parameters: |, )\$\d+ = (?:(NULL)|'((?:[^']|'')*)')`),
 		secrets:  []state.LogSecretKind{0, state.StatementParameterLogSecret, state.StatementParameterLogSecret},
 	},
+	{
+		prefixes: []string{"processing remote data for replication origin"},
+		regexp:   regexp.MustCompile(`^processing remote data for replication origin "([^"\n]+)" during message type "(\w+)"(?: (?:for|in) replication target relation "([^"\n]+)"(?: column "([^"\n]+)")?)? in transaction (\d+)(?:,? finished at (\w+/\w+))?`),
+		secrets:  []state.LogSecretKind{0, 0, 0, 0, 0, 0},
+	},
 }
 
 var autoVacuumIndexRegexp = regexp.MustCompile(`index "(.+?)": pages: (\d+) in total, (\d+) newly deleted, (\d+) currently deleted, (\d+) reusable,?\s*`)
 var parallelWorkerProcessTextRegexp = regexp.MustCompile(`^parallel worker for PID (\d+)`)
 
+// MaxAnalyzeWorkers - Maximum number of backends whose log lines are analyzed
+// concurrently by AnalyzeLogLines
+var MaxAnalyzeWorkers = runtime.GOMAXPROCS(0)
+
 func AnalyzeLogLines(logLinesIn []state.LogLine) (logLinesOut []state.LogLine, samples []state.PostgresQuerySample) {
 	// Split log lines by backend to ensure we have the right context
+	var backendPids []int32
 	backendLogLines := make(map[int32][]state.LogLine)
 
 	for _, logLine := range logLinesIn {
+		if _, ok := backendLogLines[logLine.BackendPid]; !ok {
+			backendPids = append(backendPids, logLine.BackendPid)
+		}
 		backendLogLines[logLine.BackendPid] = append(backendLogLines[logLine.BackendPid], logLine)
 	}
 
-	for _, logLines := range backendLogLines {
-		backendLogLinesOut, backendSamples := AnalyzeBackendLogLines(logLines)
-		logLinesOut = append(logLinesOut, backendLogLinesOut...)
-		samples = append(samples, backendSamples...)
+	// Analyze backends concurrently, with results kept in the order the backends
+	// first appeared in, so the output doesn't depend on worker scheduling
+	type backendResult struct {
+		logLines []state.LogLine
+		samples  []state.PostgresQuerySample
+	}
+	results := make([]backendResult, len(backendPids))
+	workers := min(MaxAnalyzeWorkers, len(backendPids))
+	if workers <= 1 {
+		for idx, pid := range backendPids {
+			results[idx].logLines, results[idx].samples = AnalyzeBackendLogLines(backendLogLines[pid])
+		}
+	} else {
+		backendIdxs := make(chan int, len(backendPids))
+		for idx := range backendPids {
+			backendIdxs <- idx
+		}
+		close(backendIdxs)
+
+		var wg sync.WaitGroup
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for idx := range backendIdxs {
+					results[idx].logLines, results[idx].samples = AnalyzeBackendLogLines(backendLogLines[backendPids[idx]])
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	for _, result := range results {
+		logLinesOut = append(logLinesOut, result.logLines...)
+		samples = append(samples, result.samples...)
 	}
 
-	// Restore the original order of the lines, which the grouping above lost (Go randomizes
-	// map iteration order). Callers rely on the byte offsets still describing the log file,
-	// in particular for its ByteSize - pganalyze discards log lines that end past that.
+	// Restore the original order of the lines, which the grouping above lost. Callers
+	// rely on the byte offsets still describing the log file, in particular for its
+	// ByteSize - pganalyze discards log lines that end past that.
 	sort.SliceStable(logLinesOut, func(i, j int) bool { return logLinesOut[i].ByteStart < logLinesOut[j].ByteStart })
 
 	return
@@ -1771,6 +1914,20 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 		contextLine = matchOtherContextLogLine(contextLine)
 		return logLine, statementLine, detailLine, contextLine, hintLine, samples
 	}
+	if matchesPrefix(logLine, connectionIdleInTransactionTimeout.primary.prefixes) {
+		logLine, _ = matchLogLine(logLine, connectionIdleInTransactionTimeout.primary)
+		logLine.Classification = connectionIdleInTransactionTimeout.classification
+		logLine.Details = map[string]interface{}{"timeout": "idle_in_transaction_session_timeout"}
+		contextLine = matchOtherContextLogLine(contextLine)
+		return logLine, statementLine, detailLine, contextLine, hintLine, samples
+	}
+	if matchesPrefix(logLine, connectionIdleSessionTimeout.primary.prefixes) {
+		logLine, _ = matchLogLine(logLine, connectionIdleSessionTimeout.primary)
+		logLine.Classification = connectionIdleSessionTimeout.classification
+		logLine.Details = map[string]interface{}{"timeout": "idle_session_timeout"}
+		contextLine = matchOtherContextLogLine(contextLine)
+		return logLine, statementLine, detailLine, contextLine, hintLine, samples
+	}
 
 	// Checkpointer
 	if matchesPrefix(logLine, checkpointStarting.primary.prefixes) {
@@ -2440,6 +2597,130 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 			return logLine, statementLine, detailLine, contextLine, hintLine, samples
 		}
 	}
+	if matchesPrefix(logLine, serverDiskFull.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, serverDiskFull.primary)
+		if len(parts) == 4 {
+			logLine.Classification = serverDiskFull.classification
+			for _, file := range parts[1:] {
+				if file != "" {
+					logLine.Details = map[string]interface{}{"file": file}
+					break
+				}
+			}
+			hintLine, _ = matchLogLine(hintLine, serverDiskFull.hint)
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, serverOutOfSharedMemory.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, serverOutOfSharedMemory.primary)
+		if len(parts) == 4 {
+			logLine.Classification = serverOutOfSharedMemory.classification
+			logLine.Details = map[string]interface{}{}
+			if parts[1] != "" {
+				size, _ := strconv.ParseInt(parts[1], 10, 64)
+				logLine.Details["size"] = size
+			}
+			if parts[2] != "" {
+				size, _ := strconv.ParseInt(parts[3], 10, 64)
+				logLine.Details["segment"] = parts[2]
+				logLine.Details["size"] = size
+			}
+			var hintParts []string
+			hintLine, hintParts = matchLogLine(hintLine, serverOutOfSharedMemory.hint)
+			if len(hintParts) == 2 {
+				logLine.Details["setting"] = hintParts[1]
+			}
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+
+	// Replication
+	if matchesPrefix(logLine, replicationTimeout.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, replicationTimeout.primary)
+		if len(parts) == 2 {
+			logLine.Classification = replicationTimeout.classification
+			logLine.Details = map[string]interface{}{"process_type": parts[1], "timeout": "wal_receiver_timeout"}
+			if parts[1] == "walsender process" {
+				logLine.Details["timeout"] = "wal_sender_timeout"
+			}
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, replicationSlotInvalidated.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, replicationSlotInvalidated.primary)
+		if len(parts) == 6 {
+			logLine.Classification = replicationSlotInvalidated.classification
+			logLine.Details = map[string]interface{}{}
+			switch {
+			case parts[1] != "":
+				logLine.Details["slot_name"] = parts[1]
+			case parts[2] != "":
+				// Postgres 13 to 15 only invalidate slots that exceed max_slot_wal_keep_size
+				logLine.Details["slot_name"] = parts[2]
+				logLine.Details["restart_lsn"] = parts[3]
+				logLine.Details["reason"] = "wal_removed"
+			case parts[5] != "":
+				processPid, _ := strconv.ParseInt(parts[4], 10, 32)
+				logLine.Details["slot_name"] = parts[5]
+				logLine.Details["process_pid"] = processPid
+				logLine.RelatedPids = []int32{int32(processPid)}
+			}
+			var detailParts []string
+			detailLine, detailParts = matchLogLine(detailLine, replicationSlotInvalidated.detail)
+			if len(detailParts) == 5 {
+				switch {
+				case detailParts[1] != "":
+					exceededBytes, _ := strconv.ParseInt(detailParts[2], 10, 64)
+					logLine.Details["restart_lsn"] = detailParts[1]
+					logLine.Details["exceeded_bytes"] = exceededBytes
+					logLine.Details["reason"] = "wal_removed"
+				case detailParts[3] != "":
+					xid, _ := strconv.ParseInt(detailParts[3], 10, 64)
+					logLine.Details["xid_horizon"] = xid
+					logLine.Details["reason"] = "rows_removed"
+				case detailParts[4] != "":
+					logLine.Details["reason"] = "wal_level_insufficient"
+				default:
+					logLine.Details["reason"] = "idle_timeout"
+				}
+			}
+			hintLine, _ = matchLogLine(hintLine, replicationSlotInvalidated.hint)
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, logicalReplicationTargetRelation.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, logicalReplicationTargetRelation.primary)
+		if len(parts) == 2 {
+			logLine.Classification = logicalReplicationTargetRelation.classification
+			logLine.Details = map[string]interface{}{"relation": parts[1]}
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, logicalReplicationSubscriptionDisabled.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, logicalReplicationSubscriptionDisabled.primary)
+		if len(parts) == 2 {
+			logLine.Classification = logicalReplicationSubscriptionDisabled.classification
+			logLine.Details = map[string]interface{}{"subscription": parts[1]}
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, logicalReplicationPublisherConnection.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, logicalReplicationPublisherConnection.primary)
+		if len(parts) == 2 {
+			logLine.Classification = logicalReplicationPublisherConnection.classification
+			if parts[1] != "" {
+				logLine.Details = map[string]interface{}{"subscription": parts[1]}
+			}
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
 
 	// Constraint violations
 	if matchesPrefix(logLine, uniqueConstraintViolation.primary.prefixes) {
@@ -478,6 +478,32 @@ var tests = []testpair{
 		}},
 		nil,
 	},
+	{
+		[]state.LogLine{{
+			Content:  "terminating connection due to idle-in-transaction timeout",
+			LogLevel: pganalyze_collector.LogLineInformation_FATAL,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_FATAL,
+			Classification:     pganalyze_collector.LogLineInformation_CONNECTION_IDLE_IN_TRANSACTION_TIMEOUT,
+			Details:            map[string]interface{}{"timeout": "idle_in_transaction_session_timeout"},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "terminating connection due to idle-session timeout",
+			LogLevel: pganalyze_collector.LogLineInformation_FATAL,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_FATAL,
+			Classification:     pganalyze_collector.LogLineInformation_CONNECTION_IDLE_SESSION_TIMEOUT,
+			Details:            map[string]interface{}{"timeout": "idle_session_timeout"},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
 	{
 		[]state.LogLine{{
 			Content:  "remaining connection slots are reserved for non-replication superuser connections",
@@ -2728,6 +2754,76 @@ index scan needed: 1 pages from table (100.00% of total) had 60 dead item identi
 		}},
 		nil,
 	},
+	{
+		[]state.LogLine{{
+			Content:  "could not extend file \"base/16384/16397\": No space left on device",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "Check free disk space.",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_DISK_FULL,
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			UUID:               uuid.UUID{1},
+			Details:            map[string]interface{}{"file": "base/16384/16397"},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "could not write to file \"pg_wal/xlogtemp.3211\": No space left on device",
+			LogLevel: pganalyze_collector.LogLineInformation_PANIC,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_DISK_FULL,
+			LogLevel:           pganalyze_collector.LogLineInformation_PANIC,
+			Details:            map[string]interface{}{"file": "pg_wal/xlogtemp.3211"},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "out of shared memory",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "You might need to increase \"max_locks_per_transaction\".",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_OUT_OF_SHARED_MEMORY,
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			UUID:               uuid.UUID{1},
+			Details:            map[string]interface{}{"setting": "max_locks_per_transaction"},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "could not resize shared memory segment \"/PostgreSQL.1402281448\" to 8388608 bytes: No space left on device",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_OUT_OF_SHARED_MEMORY,
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Details:            map[string]interface{}{"segment": "/PostgreSQL.1402281448", "size": 8388608},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
 	// Standby
 	{
 		[]state.LogLine{{
@@ -2848,6 +2944,231 @@ index scan needed: 1 pages from table (100.00% of total) had 60 dead item identi
 		}},
 		nil,
 	},
+	// Replication
+	{
+		[]state.LogLine{{
+			Content:  "terminating walsender process due to replication timeout",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			Classification:     pganalyze_collector.LogLineInformation_REPLICATION_TIMEOUT,
+			Details:            map[string]interface{}{"process_type": "walsender process", "timeout": "wal_sender_timeout"},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "terminating walreceiver due to timeout",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Classification:     pganalyze_collector.LogLineInformation_REPLICATION_TIMEOUT,
+			Details:            map[string]interface{}{"process_type": "walreceiver", "timeout": "wal_receiver_timeout"},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "invalidating obsolete replication slot \"replica_1\"",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "The slot's restart_lsn 2/8A000000 exceeds the limit by 1048576 bytes.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "You might need to increase \"max_slot_wal_keep_size\".",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Classification: pganalyze_collector.LogLineInformation_REPLICATION_SLOT_INVALIDATED,
+			UUID:           uuid.UUID{1},
+			Details: map[string]interface{}{
+				"slot_name": "replica_1", "restart_lsn": "2/8A000000",
+				"exceeded_bytes": 1048576, "reason": "wal_removed",
+			},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "invalidating slot \"replica_1\" because its restart_lsn 2/8A000000 exceeds max_slot_wal_keep_size",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Classification: pganalyze_collector.LogLineInformation_REPLICATION_SLOT_INVALIDATED,
+			Details: map[string]interface{}{
+				"slot_name": "replica_1", "restart_lsn": "2/8A000000", "reason": "wal_removed",
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "terminating process 4711 to release replication slot \"logical_slot\"",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "The slot conflicted with xid horizon 748.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}},
+		[]state.LogLine{{
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Classification: pganalyze_collector.LogLineInformation_REPLICATION_SLOT_INVALIDATED,
+			UUID:           uuid.UUID{1},
+			Details: map[string]interface{}{
+				"slot_name": "logical_slot", "process_pid": 4711,
+				"xid_horizon": 748, "reason": "rows_removed",
+			},
+			RelatedPids:        []int32{4711},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "invalidating obsolete replication slot \"standby_slot\"",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "Logical decoding on standby requires \"wal_level\" >= \"logical\" on the primary server.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			Classification:     pganalyze_collector.LogLineInformation_REPLICATION_SLOT_INVALIDATED,
+			UUID:               uuid.UUID{1},
+			Details:            map[string]interface{}{"slot_name": "standby_slot", "reason": "wal_level_insufficient"},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "logical replication target relation \"public.orders\" does not exist",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "processing remote data for replication origin \"pg_16395\" during message type \"INSERT\" in transaction 725, finished at 0/14C0378",
+			LogLevel: pganalyze_collector.LogLineInformation_CONTEXT,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Classification:     pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+			UUID:               uuid.UUID{1},
+			Details:            map[string]interface{}{"relation": "public.orders"},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_CONTEXT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "duplicate key value violates unique constraint \"orders_pkey\"",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "Key (id)=(1001) already exists.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "processing remote data for replication origin \"pg_16395\" during message type \"INSERT\" for replication target relation \"public.orders\" in transaction 725, finished at 0/14C0378",
+			LogLevel: pganalyze_collector.LogLineInformation_CONTEXT,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Classification:     pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+			UUID:               uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 10,
+				ByteEnd:   14,
+				Kind:      state.TableDataLogSecret,
+			}},
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_CONTEXT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "subscription \"sub_orders\" has been disabled because of an error",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			Classification:     pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+			Details:            map[string]interface{}{"subscription": "sub_orders"},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "could not connect to the publisher: connection to server at \"10.0.0.5\", port 5432 failed: Connection refused",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Classification:     pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 36,
+				ByteEnd:   108,
+				Kind:      state.OpsLogSecret,
+			}},
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "logical replication apply worker for subscription \"sub_orders\" could not connect to the publisher: password authentication failed for user \"repl\"",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+		}},
+		[]state.LogLine{{
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Classification:     pganalyze_collector.LogLineInformation_LOGICAL_REPLICATION_ERROR,
+			Details:            map[string]interface{}{"subscription": "sub_orders"},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 99,
+				ByteEnd:   145,
+				Kind:      state.OpsLogSecret,
+			}},
+		}},
+		nil,
+	},
 	// Constraint violations
 	{
 		[]state.LogLine{{
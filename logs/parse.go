@@ -501,8 +501,10 @@ func ParseAndAnalyzeBuffer(logStream LineReader, linesNewerThan time.Time, serve
 		}
 
 		// Ignore loglines that are ignored server-wide (e.g. because they are
-		// log_statement=all/log_duration=on lines). Note this intentionally
-		// runs after multi-line log lines have been stitched together.
+		// log_statement=all/log_duration=on lines), after accounting for them in
+		// the log-derived query statistics. Note this intentionally runs after
+		// multi-line log lines have been stitched together.
+		server.LogQueryStats.AddLogLine(logLine)
 		if server.IgnoreLogLine(logLine.Content) {
 			continue
 		}
@@ -5,6 +5,8 @@ import (
 	"sort"
 
 	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/state"
 )
 
@@ -21,22 +23,73 @@ func ReplaceSecrets(logLines []state.LogLine, filterLogSecret []state.LogSecretK
 		if filterUnidentified && (!logLine.ReviewedForSecrets || (logLine.Classification == 0 && logLines[idx].ParentUUID == uuid.Nil)) {
 			logLines[idx].Content = replacement + "\n"
 		} else {
-			sort.Slice(logLine.SecretMarkers, func(i, j int) bool {
-				return logLine.SecretMarkers[i].ByteStart < logLine.SecretMarkers[j].ByteEnd
+			sort.SliceStable(logLine.SecretMarkers, func(i, j int) bool {
+				return logLine.SecretMarkers[i].ByteStart < logLine.SecretMarkers[j].ByteStart
 			})
 			content := []byte(logLine.Content)
 			bytesChecked := -1
 			offset := 0
 			for _, m := range logLine.SecretMarkers {
-				for _, k := range filterLogSecret {
-					if m.Kind == k && m.ByteStart > bytesChecked {
-						content = slices.Replace(content, m.ByteStart-offset, m.ByteEnd-offset, []byte(replacement)...)
-						bytesChecked = m.ByteEnd
-						offset += (m.ByteEnd - m.ByteStart) - len(replacement)
-					}
+				if !slices.Contains(filterLogSecret, m.Kind) || m.ByteEnd <= bytesChecked {
+					continue
 				}
+				start := m.ByteStart
+				text := []byte(replacement)
+				if start < bytesChecked {
+					// Overlaps with the previous marker (e.g. a custom rule matching part
+					// of a statement parameter), so extend the existing redaction
+					start = bytesChecked
+					text = nil
+				}
+				content = slices.Replace(content, start-offset, m.ByteEnd-offset, text...)
+				bytesChecked = m.ByteEnd
+				offset += (m.ByteEnd - start) - len(text)
 			}
 			logLines[idx].Content = string(content)
 		}
 	}
 }
+
+// MarkCustomSecrets - Adds secret markers for all text matching the user-defined
+// filter_log_rules to already analyzed log lines, to be redacted by ReplaceSecrets
+func MarkCustomSecrets(logLines []state.LogLine, rules []config.LogRedactionRule) {
+	for idx := range logLines {
+		for _, rule := range rules {
+			for _, loc := range rule.Regexp.FindAllStringIndex(logLines[idx].Content, -1) {
+				if loc[0] == loc[1] {
+					continue
+				}
+				logLines[idx].SecretMarkers = append(logLines[idx].SecretMarkers, state.LogSecretMarker{
+					ByteStart: loc[0],
+					ByteEnd:   loc[1],
+					Kind:      state.CustomLogSecret,
+				})
+			}
+		}
+	}
+}
+
+// ReplaceCustomSecretsInQuerySamples - Redacts text matching the user-defined
+// filter_log_rules from query sample parameters, as well as from text format
+// EXPLAIN output (which may contain parameter values too)
+func ReplaceCustomSecretsInQuerySamples(samples []state.PostgresQuerySample, rules []config.LogRedactionRule) {
+	replaceAll := func(s string) string {
+		for _, rule := range rules {
+			s = rule.Regexp.ReplaceAllLiteralString(s, replacement)
+		}
+		return s
+	}
+	for idx := range samples {
+		for pIdx, param := range samples[idx].Parameters {
+			if param.Valid {
+				samples[idx].Parameters[pIdx] = null.StringFrom(replaceAll(param.String))
+			}
+		}
+		if samples[idx].ExplainOutputJSON != nil {
+			samples[idx].ExplainOutputJSON.QueryParameters = replaceAll(samples[idx].ExplainOutputJSON.QueryParameters)
+		}
+		if samples[idx].ExplainOutputText != "" {
+			samples[idx].ExplainOutputText = replaceAll(samples[idx].ExplainOutputText)
+		}
+	}
+}
@@ -6,15 +6,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/guregu/null"
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 )
 
 type replaceTestpair struct {
 	filterLogSecret string
+	filterLogRules  string
 	input           string
 	output          string
 }
@@ -70,6 +73,19 @@ var replaceTests = []replaceTestpair{
 		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 4079.697 ms  execute <unnamed>: \nSELECT * FROM x WHERE y = $1 LIMIT $2\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:DETAIL:  parameters: $1 = 'long string', $2 = '1'\n",
 		output:          "duration: 4079.697 ms  execute <unnamed>: \n[redacted]\nparameters: $1 = '[redacted]', $2 = '[redacted]'\n",
 	},
+	{
+		filterLogSecret: "none",
+		filterLogRules:  "email: [\\w.+-]+@[\\w-]+\\.[\\w.]+",
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  duplicate key value violates unique constraint \"users_email_key\"\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:DETAIL:  Key (email)=(jane@example.com) already exists.\n",
+		output:          "duplicate key value violates unique constraint \"users_email_key\"\nKey (email)=([redacted]) already exists.\n",
+	},
+	{
+		// Custom rules overlapping with built-in markers redact the text of both
+		filterLogSecret: "statement_parameter",
+		filterLogRules:  "quoted: 'long\ntenant: tenant-[0-9]+",
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 4079.697 ms  execute <unnamed>: \nSELECT * FROM x WHERE y = $1 LIMIT $2\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:DETAIL:  parameters: $1 = 'long string', $2 = 'tenant-42'\n",
+		output:          "duration: 4079.697 ms  execute <unnamed>: \nSELECT * FROM x WHERE y = $1 LIMIT $2\nparameters: $1 = [redacted]', $2 = '[redacted]'\n",
+	},
 }
 
 func TestReplaceSecrets(t *testing.T) {
@@ -78,7 +94,16 @@ func TestReplaceSecrets(t *testing.T) {
 		server := state.MakeServer(config.ServerConfig{}, false)
 		server.LogParser = logs.NewLogParser(logs.LogPrefixAmazonRds, nil, false)
 		logLines, _ := logs.ParseAndAnalyzeBuffer(reader, time.Time{}, server, state.CollectionOpts{}, &util.Logger{})
-		logs.ReplaceSecrets(logLines, state.ParseFilterLogSecret(pair.filterLogSecret))
+		filterLogSecret := state.ParseFilterLogSecret(pair.filterLogSecret)
+		if pair.filterLogRules != "" {
+			rules, err := config.ParseLogRedactionRules(pair.filterLogRules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			logs.MarkCustomSecrets(logLines, rules)
+			filterLogSecret = append(filterLogSecret, state.CustomLogSecret)
+		}
+		logs.ReplaceSecrets(logLines, filterLogSecret)
 
 		cfg := pretty.CompareConfig
 		cfg.SkipZeroFields = true
@@ -92,3 +117,35 @@ func TestReplaceSecrets(t *testing.T) {
 		}
 	}
 }
+
+func TestReplaceCustomSecretsInQuerySamples(t *testing.T) {
+	rules, err := config.ParseLogRedactionRules("email: [\\w.+-]+@[\\w-]+\\.[\\w.]+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	samples := []state.PostgresQuerySample{{
+		Query:             "SELECT * FROM users WHERE email = $1 AND name = $2",
+		Parameters:        []null.String{null.StringFrom("jane@example.com"), null.StringFrom("Jane"), null.NewString("", false)},
+		ExplainOutputJSON: &state.ExplainPlanContainer{QueryParameters: "$1 = 'jane@example.com', $2 = 'Jane'"},
+	}}
+	logs.ReplaceCustomSecretsInQuerySamples(samples, rules)
+
+	expected := []null.String{null.StringFrom("[redacted]"), null.StringFrom("Jane"), null.NewString("", false)}
+	if diff := pretty.Compare(expected, samples[0].Parameters); diff != "" {
+		t.Errorf("parameters diff: (-want +got)\n%s", diff)
+	}
+	if samples[0].ExplainOutputJSON.QueryParameters != "$1 = '[redacted]', $2 = 'Jane'" {
+		t.Errorf("want redacted EXPLAIN parameters; got %q", samples[0].ExplainOutputJSON.QueryParameters)
+	}
+
+	textSamples := []state.PostgresQuerySample{{
+		Query:             "SELECT * FROM users WHERE email = $1",
+		ExplainFormat:     pganalyze_collector.QuerySample_TEXT_EXPLAIN_FORMAT,
+		ExplainOutputText: "Query Parameters: $1 = 'jane@example.com'\nSeq Scan on users  (cost=0.00..11.93 rows=1 width=132)\n  Filter: (email = 'jane@example.com'::text)",
+	}}
+	logs.ReplaceCustomSecretsInQuerySamples(textSamples, rules)
+	expectedText := "Query Parameters: $1 = '[redacted]'\nSeq Scan on users  (cost=0.00..11.93 rows=1 width=132)\n  Filter: (email = '[redacted]'::text)"
+	if textSamples[0].ExplainOutputText != expectedText {
+		t.Errorf("want redacted text EXPLAIN output; got %q", textSamples[0].ExplainOutputText)
+	}
+}
@@ -0,0 +1,52 @@
+package stream
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats - Backpressure statistics for stream-based log analysis, across all
+// servers (counters are totals since the collector started)
+type Stats struct {
+	QueueDepth    int64         // Log lines waiting to be analyzed, as of the last analysis
+	AnalysisRuns  int64         // Number of times log lines were analyzed
+	AnalysisTime  time.Duration // Time spent analyzing log lines
+	AnalyzedLines int64         // Number of log lines that were analyzed
+	DroppedLines  int64         // Number of log lines that were dropped without being sent
+}
+
+var (
+	queueDepth    atomic.Int64
+	analysisRuns  atomic.Int64
+	analysisTime  atomic.Int64
+	analyzedLines atomic.Int64
+	droppedLines  atomic.Int64
+)
+
+// GetStats - Returns the current backpressure statistics
+func GetStats() Stats {
+	return Stats{
+		QueueDepth:    queueDepth.Load(),
+		AnalysisRuns:  analysisRuns.Load(),
+		AnalysisTime:  time.Duration(analysisTime.Load()),
+		AnalyzedLines: analyzedLines.Load(),
+		DroppedLines:  droppedLines.Load(),
+	}
+}
+
+// RecordQueueDepth - Records the number of log lines waiting to be analyzed
+func RecordQueueDepth(lines int) {
+	queueDepth.Store(int64(lines))
+}
+
+// RecordDroppedLines - Records log lines that were dropped without being sent,
+// e.g. because sending them to pganalyze failed
+func RecordDroppedLines(lines int) {
+	droppedLines.Add(int64(lines))
+}
+
+func recordAnalysis(lines int, duration time.Duration) {
+	analysisRuns.Add(1)
+	analysisTime.Add(int64(duration))
+	analyzedLines.Add(int64(lines))
+}
@@ -109,6 +109,7 @@ func findReadyLogLines(logLines []state.LogLine, now time.Time, threshold time.D
 			readyLogLines = append(readyLogLines, logLine)
 		case LogLineDiscard:
 			// Throw away this line
+			droppedLines.Add(1)
 		}
 
 		if logLine.LogLevel != pganalyze_collector.LogLineInformation_UNKNOWN {
@@ -217,6 +218,8 @@ const StreamReadyThreshold time.Duration = 3 * time.Second
 // The caller is expected to keep a repository of "tooFreshLogLines" that they
 // can send back in again in the next call, combined with new lines received
 func AnalyzeStreamInGroups(logLines []state.LogLine, now time.Time, server *state.Server, logger *util.Logger) (state.TransientLogState, state.LogFile, []state.LogLine, error) {
+	analysisStart := time.Now()
+
 	// Pre-Sort by PID, log line number and occurred at timestamp
 	//
 	// Its important we do this early, to support out-of-order receipt of log lines,
@@ -274,6 +277,7 @@ func AnalyzeStreamInGroups(logLines []state.LogLine, now time.Time, server *stat
 			logLine.LogLevel == pganalyze_collector.LogLineInformation_BACKTRACE {
 			continue
 		}
+		server.LogQueryStats.AddLogLine(logLine)
 		if !server.IgnoreLogLine(logLine.Content) {
 			analyzableLogLines = append(analyzableLogLines, logLine)
 		}
@@ -286,6 +290,7 @@ func AnalyzeStreamInGroups(logLines []state.LogLine, now time.Time, server *stat
 
 	logState := state.TransientLogState{CollectedAt: now}
 	logFile.LogLines, logState.QuerySamples = logs.AnalyzeLogLines(analyzableLogLines)
+	recordAnalysis(len(analyzableLogLines), time.Since(analysisStart))
 
 	return logState, logFile, tooFreshLogLines, nil
 }
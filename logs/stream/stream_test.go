@@ -1,14 +1,18 @@
 package stream_test
 
 import (
+	"io"
 	"log"
 	"os"
+	"runtime"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/logs/stream"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
@@ -389,3 +393,78 @@ func TestAnalyzeStreamInGroups(t *testing.T) {
 		}
 	}
 }
+
+// Log lines from many concurrently active backends, as seen on busy servers
+func makeHighVolumeLogLines(backends int, linesPerBackend int) []state.LogLine {
+	var logLines []state.LogLine
+	for i := 0; i < linesPerBackend; i++ {
+		for pid := int32(1); pid <= int32(backends); pid++ {
+			logLines = append(logLines, state.LogLine{
+				CollectedAt: now.Add(-5 * time.Second),
+				BackendPid:  pid,
+				LogLevel:    pganalyze_collector.LogLineInformation_LOG,
+				Content:     "duration: 1042.112 ms  statement: SELECT * FROM users WHERE id = " + strconv.Itoa(i) + ";\n",
+			}, state.LogLine{
+				CollectedAt: now.Add(-5 * time.Second),
+				BackendPid:  pid,
+				LogLevel:    pganalyze_collector.LogLineInformation_ERROR,
+				Content:     "duplicate key value violates unique constraint \"users_pkey\"\n",
+			}, state.LogLine{
+				CollectedAt: now.Add(-5 * time.Second),
+				BackendPid:  pid,
+				LogLevel:    pganalyze_collector.LogLineInformation_DETAIL,
+				Content:     "Key (id)=(" + strconv.Itoa(i) + ") already exists.\n",
+			})
+		}
+	}
+	return logLines
+}
+
+func TestAnalyzeStreamInGroupsParallel(t *testing.T) {
+	defer func(workers int) { logs.MaxAnalyzeWorkers = workers }(logs.MaxAnalyzeWorkers)
+	logLines := makeHighVolumeLogLines(50, 10)
+	server := state.MakeServer(config.ServerConfig{}, false)
+	logger := &util.Logger{Destination: log.New(os.Stderr, "", log.LstdFlags)}
+
+	logs.MaxAnalyzeWorkers = 1
+	sequentialState, sequentialFile, _, err := stream.AnalyzeStreamInGroups(logLines, now, server, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs.MaxAnalyzeWorkers = 8
+	parallelState, parallelFile, _, err := stream.AnalyzeStreamInGroups(logLines, now, server, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := pretty.Compare(sequentialFile.LogLines, parallelFile.LogLines); diff != "" {
+		t.Errorf("log lines diff: (-sequential +parallel)\n%s", diff)
+	}
+	if diff := pretty.Compare(sequentialState.QuerySamples, parallelState.QuerySamples); diff != "" {
+		t.Errorf("query samples diff: (-sequential +parallel)\n%s", diff)
+	}
+}
+
+// Compares analyzing backends one at a time against the worker pool (run with
+// -cpu to compare different core counts)
+func BenchmarkAnalyzeStreamInGroups(b *testing.B) {
+	defer func(workers int) { logs.MaxAnalyzeWorkers = workers }(logs.MaxAnalyzeWorkers)
+	logLines := makeHighVolumeLogLines(200, 50)
+	server := state.MakeServer(config.ServerConfig{}, false)
+	logger := &util.Logger{Destination: log.New(io.Discard, "", log.LstdFlags)}
+
+	for _, bench := range []struct {
+		name    string
+		workers int
+	}{
+		{"sequential", 1},
+		{"parallel", runtime.GOMAXPROCS(0)},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			logs.MaxAnalyzeWorkers = bench.workers
+			for i := 0; i < b.N; i++ {
+				stream.AnalyzeStreamInGroups(logLines, now, server, logger)
+			}
+		})
+	}
+}
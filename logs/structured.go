@@ -0,0 +1,173 @@
+package logs
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// This file handles the structured log formats that Postgres can write
+// (log_destination = csvlog or jsonlog), as well as structured records derived
+// from them (e.g. CloudNativePG's JSON output, which wraps csvlog fields).
+//
+// Records are represented as a map of field names to values, using the csvlog
+// column names. The differing jsonlog key names are mapped to these, see
+// jsonlogFieldNames.
+
+// CsvlogColumns - Column names of the csvlog format, in the order they are written
+//
+// Older Postgres versions omit the trailing columns (backend_type was added in
+// Postgres 13, leader_pid and query_id in Postgres 14).
+var CsvlogColumns = []string{
+	"log_time",
+	"user_name",
+	"database_name",
+	"process_id",
+	"connection_from",
+	"session_id",
+	"session_line_num",
+	"command_tag",
+	"session_start_time",
+	"virtual_transaction_id",
+	"transaction_id",
+	"error_severity",
+	"sql_state_code",
+	"message",
+	"detail",
+	"hint",
+	"internal_query",
+	"internal_query_pos",
+	"context",
+	"query",
+	"query_pos",
+	"location",
+	"application_name",
+	"backend_type",
+	"leader_pid",
+	"query_id",
+}
+
+// jsonlogFieldNames maps jsonlog keys to the equivalent csvlog column names
+var jsonlogFieldNames = map[string]string{
+	"timestamp":         "log_time",
+	"user":              "user_name",
+	"dbname":            "database_name",
+	"pid":               "process_id",
+	"remote_host":       "connection_from",
+	"line_num":          "session_line_num",
+	"ps":                "command_tag",
+	"session_start":     "session_start_time",
+	"vxid":              "virtual_transaction_id",
+	"txid":              "transaction_id",
+	"state_code":        "sql_state_code",
+	"statement":         "query",
+	"cursor_position":   "query_pos",
+	"internal_position": "internal_query_pos",
+}
+
+// ParseCsvlogRecord - Parses a single (possibly multi-line) csvlog record
+func ParseCsvlogRecord(record string) (map[string]string, error) {
+	reader := csv.NewReader(strings.NewReader(record))
+	reader.FieldsPerRecord = -1
+	fields, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) < 14 {
+		return nil, fmt.Errorf("unexpected csvlog record with %d columns", len(fields))
+	}
+
+	result := make(map[string]string)
+	for idx, value := range fields {
+		if idx >= len(CsvlogColumns) {
+			break
+		}
+		result[CsvlogColumns[idx]] = value
+	}
+	return result, nil
+}
+
+// ParseJsonlogRecord - Parses a single jsonlog record, returning it keyed by the
+// csvlog column names
+//
+// Records that already use the csvlog column names (e.g. as written by
+// CloudNativePG) are accepted as well.
+func ParseJsonlogRecord(record string) (map[string]string, error) {
+	var fields map[string]any
+	decoder := json.NewDecoder(strings.NewReader(record))
+	decoder.UseNumber()
+	err := decoder.Decode(&fields)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fields["error_severity"]; !ok {
+		return nil, fmt.Errorf("unexpected jsonlog record without error_severity")
+	}
+
+	result := make(map[string]string)
+	for key, value := range fields {
+		if name, ok := jsonlogFieldNames[key]; ok {
+			key = name
+		}
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		case json.Number:
+			result[key] = v.String()
+		case bool:
+			result[key] = strconv.FormatBool(v)
+		}
+	}
+	return result, nil
+}
+
+// LogLinesFromStructuredRecord - Converts a csvlog/jsonlog record into log lines
+//
+// The first line contains the main message, and additional lines are returned for
+// the DETAIL, HINT, CONTEXT and STATEMENT fields (if set), matching the lines
+// Postgres writes in the stderr log format.
+func LogLinesFromStructuredRecord(record map[string]string, logParser state.LogParser) []state.LogLine {
+	var logLine state.LogLine
+
+	if logParser != nil {
+		logLine.OccurredAt = logParser.GetOccurredAt(record["log_time"])
+	}
+	logLine.Username = record["user_name"]
+	logLine.Database = record["database_name"]
+	logLine.Application = record["application_name"]
+	if backendPid, err := strconv.ParseInt(record["process_id"], 10, 32); err == nil {
+		logLine.BackendPid = int32(backendPid)
+	}
+	if logLineNumber, err := strconv.ParseInt(record["session_line_num"], 10, 32); err == nil {
+		logLine.LogLineNumber = int32(logLineNumber)
+	}
+	logLine.LogLevel = pganalyze_collector.LogLineInformation_LogLevel(pganalyze_collector.LogLineInformation_LogLevel_value[record["error_severity"]])
+	logLine.Content = record["message"] + "\n"
+
+	logLines := []state.LogLine{logLine}
+	for _, additional := range []struct {
+		field    string
+		logLevel pganalyze_collector.LogLineInformation_LogLevel
+	}{
+		{"detail", pganalyze_collector.LogLineInformation_DETAIL},
+		{"hint", pganalyze_collector.LogLineInformation_HINT},
+		{"context", pganalyze_collector.LogLineInformation_CONTEXT},
+		{"query", pganalyze_collector.LogLineInformation_STATEMENT},
+	} {
+		content := record[additional.field]
+		if content == "" {
+			continue
+		}
+		additionalLine := logLine
+		additionalLine.Content = content + "\n"
+		additionalLine.LogLevel = additional.logLevel
+		logLines = append(logLines, additionalLine)
+	}
+
+	return logLines
+}
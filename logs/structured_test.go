@@ -0,0 +1,110 @@
+package logs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+type structuredTestpair struct {
+	csvlog  string
+	jsonlog string
+	output  []state.LogLine
+}
+
+var structuredTests = []structuredTestpair{
+	{
+		// Postgres 16 csvlog, with a multi-line query and quotes in the message
+		`2024-05-01 10:11:12.345 UTC,"app_user","app_db",1234,"127.0.0.1:5432",663212b0.4d2,7,"SELECT",2024-05-01 10:00:00 UTC,3/42,0,ERROR,42703,"column ""foo"" does not exist",,"Perhaps you meant to reference the column ""t.fob"".",,,,"SELECT foo
+  FROM t",8,,"psql","client backend",,0`,
+		`{"timestamp":"2024-05-01 10:11:12.345 UTC","user":"app_user","dbname":"app_db","pid":1234,"remote_host":"127.0.0.1","remote_port":5432,"session_id":"663212b0.4d2","line_num":7,"ps":"SELECT","session_start":"2024-05-01 10:00:00 UTC","vxid":"3/42","txid":0,"error_severity":"ERROR","state_code":"42703","message":"column \"foo\" does not exist","hint":"Perhaps you meant to reference the column \"t.fob\".","statement":"SELECT foo\n  FROM t","cursor_position":8,"application_name":"psql","backend_type":"client backend","query_id":0}`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_ERROR,
+				Content:       "column \"foo\" does not exist\n",
+			},
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_HINT,
+				Content:       "Perhaps you meant to reference the column \"t.fob\".\n",
+			},
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 345000000, time.UTC),
+				Username:      "app_user",
+				Database:      "app_db",
+				Application:   "psql",
+				BackendPid:    1234,
+				LogLineNumber: 7,
+				LogLevel:      pganalyze_collector.LogLineInformation_STATEMENT,
+				Content:       "SELECT foo\n  FROM t\n",
+			},
+		},
+	},
+	{
+		// Postgres 12 csvlog (no backend_type, leader_pid or query_id columns), background process
+		`2024-05-01 10:11:12.000 UTC,,,99,,663212b0.63,1,,2024-05-01 10:00:00 UTC,,0,LOG,00000,"checkpoint starting: time",,,,,,,,,""`,
+		`{"timestamp":"2024-05-01 10:11:12.000 UTC","pid":99,"session_id":"663212b0.63","line_num":1,"session_start":"2024-05-01 10:00:00 UTC","txid":0,"error_severity":"LOG","state_code":"00000","message":"checkpoint starting: time","backend_type":"checkpointer","query_id":0}`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2024, time.May, 1, 10, 11, 12, 0, time.UTC),
+				BackendPid:    99,
+				LogLineNumber: 1,
+				LogLevel:      pganalyze_collector.LogLineInformation_LOG,
+				Content:       "checkpoint starting: time\n",
+			},
+		},
+	},
+}
+
+func TestLogLinesFromStructuredRecord(t *testing.T) {
+	parser := logs.NewLogParser(logs.LogPrefixEmpty, nil, false)
+
+	for _, pair := range structuredTests {
+		record, err := logs.ParseCsvlogRecord(pair.csvlog)
+		if err != nil {
+			t.Errorf("ParseCsvlogRecord: %s", err)
+			continue
+		}
+		logLines := logs.LogLinesFromStructuredRecord(record, parser)
+		if diff := pretty.Compare(pair.output, logLines); diff != "" {
+			t.Errorf("Unexpected csvlog result for %q:\n diff: (-want +got)\n%s", pair.csvlog, diff)
+		}
+
+		record, err = logs.ParseJsonlogRecord(pair.jsonlog)
+		if err != nil {
+			t.Errorf("ParseJsonlogRecord: %s", err)
+			continue
+		}
+		logLines = logs.LogLinesFromStructuredRecord(record, parser)
+		if diff := pretty.Compare(pair.output, logLines); diff != "" {
+			t.Errorf("Unexpected jsonlog result for %q:\n diff: (-want +got)\n%s", pair.jsonlog, diff)
+		}
+	}
+}
+
+func TestParseStructuredRecordErrors(t *testing.T) {
+	if _, err := logs.ParseCsvlogRecord(`2024-05-01 10:11:12.000 UTC,,,99`); err == nil {
+		t.Errorf("ParseCsvlogRecord: want error for truncated record; got nil")
+	}
+	if _, err := logs.ParseJsonlogRecord(`{"message":"hello"}`); err == nil {
+		t.Errorf("ParseJsonlogRecord: want error for record without error_severity; got nil")
+	}
+	if _, err := logs.ParseJsonlogRecord(`{"message":`); err == nil {
+		t.Errorf("ParseJsonlogRecord: want error for invalid JSON; got nil")
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -42,6 +43,7 @@ func main() {
 	var analyzeDebugClassifications string
 	var filterLogFile string
 	var filterLogSecret string
+	var filterLogRules stringListFlag
 	var debugLogs bool
 	var discoverLogLocation bool
 	var testRun bool
@@ -51,6 +53,8 @@ func main() {
 	var generateStatsHelperSql string
 	var generateHelperExplainAnalyzeSql string
 	var generateHelperExplainAnalyzeRole string
+	var generateHelperMaintenanceSql string
+	var generateHelperMaintenanceRole string
 	var forceStateUpdate bool
 	var configFilename string
 	var stateFilename string
@@ -64,6 +68,8 @@ func main() {
 	var logNoTimestamps bool
 	var reload bool
 	var noReload bool
+	var noConfigWatch bool
+	var validateConfig bool
 	var benchmark bool
 	var veryVerbose bool
 
@@ -78,8 +84,12 @@ func main() {
 	flag.StringVar(&generateStatsHelperSql, "generate-stats-helper-sql", "", "Generates a SQL script for the given server (name of section in the config file, or \"default\" for env variables), that can be run with \"psql -f\" for installing the collector stats helpers on all configured databases")
 	flag.StringVar(&generateHelperExplainAnalyzeSql, "generate-explain-analyze-helper-sql", "", "Generates a SQL script for the given server (name of section in the config file, or \"default\" for env variables), that can be run with \"psql -f\" for installing the collector pganalyze.explain_analyze helper on all configured databases")
 	flag.StringVar(&generateHelperExplainAnalyzeRole, "generate-explain-analyze-helper-role", "pganalyze_explain", "Sets owner role of the pganalyze.explain_analyze helper function, defaults to \"pganalyze_explain\"")
+	flag.StringVar(&generateHelperMaintenanceSql, "generate-maintenance-helper-sql", "", "Generates a SQL script for the given server (name of section in the config file, or \"default\" for env variables), that can be run with \"psql -f\" for installing the collector pganalyze.maintenance_role and pganalyze.analyze_table helpers on all configured databases, which opts into running ANALYZE from pganalyze (REINDEX and CREATE/DROP INDEX additionally require granting the maintenance role to the monitoring user, see the warning in the generated script)")
+	flag.StringVar(&generateHelperMaintenanceRole, "generate-maintenance-helper-role", "pganalyze_maintenance", "Sets owner role of the pganalyze.maintenance_role helper function, which maintenance commands run as, defaults to \"pganalyze_maintenance\"")
 	flag.BoolVar(&reload, "reload", false, "Reloads the collector daemon that's running on the host")
 	flag.BoolVar(&noReload, "no-reload", false, "Disables automatic config reloading during a test run")
+	flag.BoolVar(&validateConfig, "validate-config", false, "Validates the config file without connecting to any databases or APIs, outputs the result as JSON and exits (with a non-zero exit code if problems were found)")
+	flag.BoolVar(&noConfigWatch, "no-config-watch", false, "Disables automatic config reloading when the config file (or files referenced by it) change")
 	flag.BoolVarP(&logger.Verbose, "verbose", "v", false, "Outputs additional debugging information, use this if you're encountering errors or other problems")
 	flag.BoolVar(&veryVerbose, "very-verbose", false, "Enable very verbose logging (will also enable verbose logging)")
 	flag.BoolVarP(&logger.Quiet, "quiet", "q", false, "Only outputs error messages to the logs and hides informational and warning messages")
@@ -89,11 +99,12 @@ func main() {
 	flag.BoolVar(&dryRun, "dry-run", false, "Print JSON data that would get sent to web service (without actually sending) and exit afterwards")
 	flag.BoolVar(&dryRunLogs, "dry-run-logs", false, "Print JSON data for log snapshot (without actually sending) and exit afterwards")
 	flag.StringVar(&analyzeLogfile, "analyze-logfile", "", "Analyzes the content of the given log file and returns debug output about it")
-	flag.StringVar(&analyzeLogfilePrefix, "analyze-logfile-prefix", "", "The log_line_prefix to use with --analyze-logfile")
-	flag.StringVar(&analyzeLogfileTz, "analyze-logfile-tz", "", "The log_timezone to use with --analyze-logfile (default: UTC)")
+	flag.StringVar(&analyzeLogfilePrefix, "analyze-logfile-prefix", "", "The log_line_prefix to use with --analyze-logfile and --filter-logfile")
+	flag.StringVar(&analyzeLogfileTz, "analyze-logfile-tz", "", "The log_timezone to use with --analyze-logfile and --filter-logfile (default: UTC)")
 	flag.StringVar(&analyzeDebugClassifications, "analyze-debug-classifications", "", "When used with --analyze-logfile, print detailed information about given classifications (can be comma-separated list of integer classifications, or keyword 'all')")
 	flag.StringVar(&filterLogFile, "filter-logfile", "", "Test command that filters all known secrets in the logfile according to the filter-log-secret option")
 	flag.StringVar(&filterLogSecret, "filter-log-secret", "all", "Sets the type of secrets filtered by the filter-logfile test command (default: all)")
+	flag.Var(&filterLogRules, "filter-log-rule", "Adds a \"name: regexp\" rule for additional text filtered by the filter-logfile test command, same as filter_log_rules (can be specified multiple times, and is combined with the filter_log_rules in the file given with --config)")
 	flag.BoolVar(&debugLogs, "debug-logs", false, "Outputs all log analysis that would be sent, doesn't send any other data. For some providers, it also outputs incoming logs from the source (use for debugging only)")
 	flag.BoolVar(&discoverLogLocation, "discover-log-location", false, "Tries to automatically discover the location of the Postgres log directory, to support configuring the 'db_log_location' setting")
 	flag.BoolVar(&forceStateUpdate, "force-state-update", false, "Updates the state file even if other options would have prevented it (intended to be used together with --dry-run for debugging)")
@@ -145,7 +156,21 @@ func main() {
 		}
 	}
 
-	if testRunLogs || testRunAndTrace || testExplain || generateStatsHelperSql != "" || generateHelperExplainAnalyzeSql != "" {
+	if validateConfig {
+		result := config.Validate(configFilename)
+		output, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			fmt.Printf("ERROR: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("%s\n", output)
+		if !result.Valid {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if testRunLogs || testRunAndTrace || testExplain || generateStatsHelperSql != "" || generateHelperExplainAnalyzeSql != "" || generateHelperMaintenanceSql != "" {
 		testRun = true
 	}
 
@@ -163,6 +188,8 @@ func main() {
 		GenerateStatsHelperSql:           generateStatsHelperSql,
 		GenerateExplainAnalyzeHelperSql:  generateHelperExplainAnalyzeSql,
 		GenerateExplainAnalyzeHelperRole: generateHelperExplainAnalyzeRole,
+		GenerateMaintenanceHelperSql:     generateHelperMaintenanceSql,
+		GenerateMaintenanceHelperRole:    generateHelperMaintenanceRole,
 		DebugLogs:                        debugLogs,
 		DiscoverLogLocation:              discoverLogLocation,
 		CollectPostgresRelations:         !noPostgresRelations,
@@ -243,8 +270,49 @@ func main() {
 			return
 		}
 		logReader := logs.NewMaybeHerokuLogReader(strings.NewReader(string(contentBytes)))
-		logLines, _ := logs.ParseAndAnalyzeBuffer(logReader, time.Time{}, state.MakeServer(config.ServerConfig{}, false), opts, logger)
-		logs.ReplaceSecrets(logLines, state.ParseFilterLogSecret(filterLogSecret))
+		server := state.MakeServer(config.ServerConfig{}, false)
+		tz, err := time.LoadLocation(analyzeLogfileTz)
+		if err != nil {
+			fmt.Printf("ERROR: could not read time zone: %s\n", err)
+			return
+		}
+		if analyzeLogfilePrefix == "" {
+			fmt.Println("ERROR: must specify log_line_prefix used to generate logfile with --analyze-logfile-prefix")
+			return
+		}
+		server.LogParser = logs.NewLogParser(analyzeLogfilePrefix, tz, false)
+		logLines, _ := logs.ParseAndAnalyzeBuffer(logReader, time.Time{}, server, opts, logger)
+		filterLogSecretKinds := state.ParseFilterLogSecret(filterLogSecret)
+		var rules []config.LogRedactionRule
+		configSpecified := false
+		flag.Visit(func(f *flag.Flag) {
+			configSpecified = configSpecified || f.Name == "config"
+		})
+		if configSpecified {
+			// Test the filter_log_rules of all servers in the config file
+			conf, err := config.Read(false, logger, configFilename)
+			if err != nil {
+				fmt.Printf("ERROR: %s\n", err)
+				return
+			}
+			conf.RemoveTempFiles()
+			for _, server := range conf.Servers {
+				rules = append(rules, server.FilterLogRulesParsed...)
+			}
+		}
+		if len(filterLogRules) > 0 {
+			flagRules, err := config.ParseLogRedactionRules(strings.Join(filterLogRules, "\n"))
+			if err != nil {
+				fmt.Printf("ERROR: %s\n", err)
+				return
+			}
+			rules = append(rules, flagRules...)
+		}
+		if len(rules) > 0 {
+			logs.MarkCustomSecrets(logLines, rules)
+			filterLogSecretKinds = append(filterLogSecretKinds, state.CustomLogSecret)
+		}
+		logs.ReplaceSecrets(logLines, filterLogSecretKinds)
 		output := ""
 		for _, logLine := range logLines {
 			output += logLine.Content
@@ -287,14 +355,19 @@ ReadConfigAndRun:
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := sync.WaitGroup{}
 	exitCode := 0
-	keepRunning, testRunSuccess, writeStateFile, shutdown := runner.Run(ctx, &wg, opts, logger, configFilename)
+	var configChanged chan struct{}
+	if !noConfigWatch && !testRun && !opts.TestRun && !opts.DiscoverLogLocation {
+		configChanged = make(chan struct{}, 1)
+	}
+	keepRunning, testRunSuccess, writeStateFile, shutdown := runner.Run(ctx, &wg, opts, logger, configFilename, configChanged)
 
 	if keepRunning {
-		// Block here until we get any of the registered signals
-		s := <-sigs
-
-		if s == syscall.SIGHUP {
-			if writeHeapProfile {
+		// Block here until we get any of the registered signals, or the config changes
+		reloadConfig := false
+		select {
+		case s := <-sigs:
+			reloadConfig = s == syscall.SIGHUP
+			if reloadConfig && writeHeapProfile {
 				usr, err := user.Current()
 				if err == nil {
 					mprofPath := usr.HomeDir + "/pganalyze_collector.mprof"
@@ -306,6 +379,11 @@ ReadConfigAndRun:
 					}
 				}
 			}
+		case <-configChanged:
+			reloadConfig = true
+		}
+
+		if reloadConfig {
 			logger.PrintInfo("Reloading configuration...")
 			shutdown()
 			cancel()
@@ -374,3 +452,15 @@ func Reload(logger *util.Logger) {
 	logger.PrintInfo("Successfully reloaded pganalyze collector (PID %d)\n", pid)
 	os.Exit(0)
 }
+
+// Flag value that collects all values when a flag is specified multiple times
+type stringListFlag []string
+
+func (l *stringListFlag) String() string {
+	return strings.Join(*l, ", ")
+}
+
+func (l *stringListFlag) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/pganalyze/collector/output/transform"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func SubmitCompactActivitySnapshot(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, activityState state.TransientActivityState) error {
@@ -33,14 +32,7 @@ func SubmitCompactActivitySnapshot(ctx context.Context, server *state.Server, co
 
 	server.QueryRunsMutex.Lock()
 	for _, query := range server.QueryRuns {
-		as.QueryRuns = append(as.QueryRuns, &pganalyze_collector.QueryRun{
-			Id:         query.Id,
-			StartedAt:  timestamppb.New(query.StartedAt),
-			FinishedAt: timestamppb.New(query.FinishedAt),
-			Result:     query.Result,
-			Error:      query.Error,
-			BackendPid: int32(query.BackendPid),
-		})
+		as.QueryRuns = append(as.QueryRuns, transformQueryRun(*query))
 	}
 	server.QueryRunsMutex.Unlock()
 
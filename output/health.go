@@ -0,0 +1,148 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// ServerHealth - Status of a single server as reported by the health endpoints
+type ServerHealth struct {
+	Server string `json:"server"`
+
+	// Whether the collector is alive (scheduled collection is running), and ready
+	// (data is being collected and sent successfully)
+	Alive bool `json:"alive"`
+	Ready bool `json:"ready"`
+
+	// Human-readable reasons for the server not being alive or ready
+	Problems []string `json:"problems,omitempty"`
+
+	GrantValid                bool       `json:"grant_valid"`
+	WebSocketConnected        bool       `json:"websocket_connected"`
+	LastFullSnapshotAt        *time.Time `json:"last_full_snapshot_at"`
+	LastFullSnapshotFailureAt *time.Time `json:"last_full_snapshot_failure_at"`
+	LastLogsReceivedAt        *time.Time `json:"last_logs_received_at"`
+	CollectionDisabled        bool       `json:"collection_disabled"`
+	CollectionDisabledReason  string     `json:"collection_disabled_reason,omitempty"`
+	LogSnapshotDisabled       bool       `json:"log_snapshot_disabled"`
+	LogSnapshotDisabledReason string     `json:"log_snapshot_disabled_reason,omitempty"`
+}
+
+type healthResponse struct {
+	Status  string         `json:"status"`
+	Servers []ServerHealth `json:"servers"`
+}
+
+// GetServerHealth - Determines the health of the server based on its most recent collector runs
+//
+// The server is considered alive as long as full snapshots are attempted within
+// the configured maximum age (regardless of whether they succeed), or the
+// collector was only started recently. To be ready, the last full snapshot must
+// have succeeded within the maximum age, the API grant must be valid, the
+// WebSocket must be connected (if required), and log data must have been
+// received recently (if a maximum log age is configured).
+func GetServerHealth(server *state.Server, now time.Time, collectorStartedAt time.Time) ServerHealth {
+	health := ServerHealth{
+		Server:     server.Config.SectionName,
+		Alive:      true,
+		Ready:      true,
+		GrantValid: server.Grant.Load().ValidConfig,
+	}
+	if server.WebSocket != nil {
+		health.WebSocketConnected = server.WebSocket.Connected()
+	}
+
+	server.CollectionStatusMutex.Lock()
+	health.CollectionDisabled = server.CollectionStatus.CollectionDisabled
+	health.CollectionDisabledReason = server.CollectionStatus.CollectionDisabledReason
+	health.LogSnapshotDisabled = server.CollectionStatus.LogSnapshotDisabled
+	health.LogSnapshotDisabledReason = server.CollectionStatus.LogSnapshotDisabledReason
+	server.CollectionStatusMutex.Unlock()
+
+	notAlive := func(format string, args ...any) {
+		health.Alive = false
+		health.Ready = false
+		health.Problems = append(health.Problems, fmt.Sprintf(format, args...))
+	}
+	notReady := func(format string, args ...any) {
+		health.Ready = false
+		health.Problems = append(health.Problems, fmt.Sprintf(format, args...))
+	}
+
+	maxAge := server.Config.HealthFullSnapshotMaxAgeParsed
+	run, _ := server.Metrics.Run("full")
+	if !run.LastSuccessAt.IsZero() {
+		health.LastFullSnapshotAt = &run.LastSuccessAt
+	}
+	if !run.LastFailureAt.IsZero() {
+		health.LastFullSnapshotFailureAt = &run.LastFailureAt
+	}
+	lastAttemptAt := run.LastSuccessAt
+	if run.LastFailureAt.After(lastAttemptAt) {
+		lastAttemptAt = run.LastFailureAt
+	}
+
+	// Full snapshots are intentionally skipped when collection is disabled (e.g.
+	// for replicas with skip_if_replica), which should not be reported as a problem
+	if !health.CollectionDisabled {
+		if lastAttemptAt.IsZero() {
+			if now.Sub(collectorStartedAt) > maxAge {
+				notAlive("no full snapshot attempted since collector start %s ago", now.Sub(collectorStartedAt).Round(time.Second))
+			} else {
+				notReady("waiting for first full snapshot")
+			}
+		} else if now.Sub(lastAttemptAt) > maxAge {
+			notAlive("last full snapshot attempted %s ago", now.Sub(lastAttemptAt).Round(time.Second))
+		} else if run.LastSuccessAt.IsZero() || now.Sub(run.LastSuccessAt) > maxAge {
+			notReady("no successful full snapshot within %s", maxAge)
+		}
+	}
+
+	if !health.GrantValid {
+		notReady("no valid API grant")
+	}
+	if server.Config.APIRequireWebsocket && !health.WebSocketConnected {
+		notReady("WebSocket not connected")
+	}
+
+	logsReceivedAt := server.Metrics.LogsReceivedAt()
+	if !logsReceivedAt.IsZero() {
+		health.LastLogsReceivedAt = &logsReceivedAt
+	}
+	logMaxAge := server.Config.HealthLogMaxAgeParsed
+	if logMaxAge > 0 && !server.Config.DisableLogs && !health.LogSnapshotDisabled && !health.CollectionDisabled {
+		if logsReceivedAt.IsZero() {
+			if now.Sub(collectorStartedAt) > logMaxAge {
+				notReady("no log data received since collector start")
+			}
+		} else if now.Sub(logsReceivedAt) > logMaxAge {
+			notReady("last log data received %s ago", now.Sub(logsReceivedAt).Round(time.Second))
+		}
+	}
+
+	return health
+}
+
+func healthHandler(servers []*state.Server, collectorStartedAt time.Time, readiness bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		response := healthResponse{Status: "ok"}
+		for _, server := range servers {
+			health := GetServerHealth(server, now, collectorStartedAt)
+			if (readiness && !health.Ready) || !health.Alive {
+				response.Status = "failing"
+			}
+			response.Servers = append(response.Servers, health)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if response.Status != "ok" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(response)
+	}
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+func makeHealthTestServer(logMaxAge time.Duration) *state.Server {
+	server := state.MakeServer(config.ServerConfig{
+		SectionName:                    "default",
+		HealthListenAddress:            "localhost:0",
+		HealthFullSnapshotMaxAgeParsed: 30 * time.Minute,
+		HealthLogMaxAgeParsed:          logMaxAge,
+	}, false)
+	server.Grant.Store(&state.Grant{ValidConfig: true})
+	return server
+}
+
+func TestGetServerHealth(t *testing.T) {
+	now := time.Now()
+
+	type testItem struct {
+		name          string
+		setup         func(server *state.Server)
+		startedAt     time.Time
+		logMaxAge     time.Duration
+		expectedAlive bool
+		expectedReady bool
+	}
+
+	tests := []testItem{
+		{"starting up", func(server *state.Server) {}, now.Add(-time.Minute), 0, true, false},
+		{"never ran", func(server *state.Server) {}, now.Add(-time.Hour), 0, false, false},
+		{"recent success", func(server *state.Server) {
+			server.Metrics.RecordRun("full", time.Second, nil)
+		}, now.Add(-time.Hour), 0, true, true},
+		{"recent failure", func(server *state.Server) {
+			server.Metrics.RecordRun("full", time.Second, errors.New("connection refused"))
+		}, now.Add(-time.Hour), 0, true, false},
+		{"invalid grant", func(server *state.Server) {
+			server.Metrics.RecordRun("full", time.Second, nil)
+			server.Grant.Store(&state.Grant{})
+		}, now.Add(-time.Hour), 0, true, false},
+		{"collection disabled", func(server *state.Server) {
+			server.CollectionStatus.CollectionDisabled = true
+		}, now.Add(-time.Hour), 0, true, true},
+		{"no logs received", func(server *state.Server) {
+			server.Metrics.RecordRun("full", time.Second, nil)
+		}, now.Add(-time.Hour), 15 * time.Minute, true, false},
+		{"stale logs", func(server *state.Server) {
+			server.Metrics.RecordRun("full", time.Second, nil)
+			server.Metrics.RecordLogsReceived(now.Add(-20 * time.Minute))
+		}, now.Add(-time.Hour), 15 * time.Minute, true, false},
+		{"recent logs", func(server *state.Server) {
+			server.Metrics.RecordRun("full", time.Second, nil)
+			server.Metrics.RecordLogsReceived(now.Add(-time.Minute))
+		}, now.Add(-time.Hour), 15 * time.Minute, true, true},
+	}
+
+	for _, item := range tests {
+		server := makeHealthTestServer(item.logMaxAge)
+		item.setup(server)
+		health := GetServerHealth(server, now, item.startedAt)
+		if health.Alive != item.expectedAlive {
+			t.Errorf("%s: alive: want %v; got %v (%v)", item.name, item.expectedAlive, health.Alive, health.Problems)
+		}
+		if health.Ready != item.expectedReady {
+			t.Errorf("%s: ready: want %v; got %v (%v)", item.name, item.expectedReady, health.Ready, health.Problems)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+type archivedLogLine struct {
+	Server         string                 `json:"server"`
+	UUID           string                 `json:"uuid"`
+	ParentUUID     string                 `json:"parent_uuid,omitempty"`
+	OccurredAt     time.Time              `json:"occurred_at"`
+	LogLevel       string                 `json:"log_level"`
+	Classification string                 `json:"classification,omitempty"`
+	BackendPid     int32                  `json:"backend_pid,omitempty"`
+	Database       string                 `json:"database,omitempty"`
+	Username       string                 `json:"username,omitempty"`
+	Application    string                 `json:"application,omitempty"`
+	Content        string                 `json:"content"`
+	Details        map[string]interface{} `json:"details,omitempty"`
+	RelatedPids    []int32                `json:"related_pids,omitempty"`
+}
+
+// ArchiveLogs - Queues the log lines for the local log archive, one JSON object
+// per line, after secrets have been filtered from them
+//
+// The query text of log lines is intentionally not included, since it isn't
+// covered by the secret markers (the content has the same information).
+func ArchiveLogs(server *state.Server, logState state.TransientLogState, logger *util.Logger) {
+	var buf bytes.Buffer
+	lines := 0
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false) // Keep e.g. "execute <unnamed>" readable
+	for _, logFile := range logState.LogFiles {
+		for _, logLine := range logFile.LogLines {
+			archived := archivedLogLine{
+				Server:      server.Config.SectionName,
+				UUID:        logLine.UUID.String(),
+				OccurredAt:  logLine.OccurredAt,
+				LogLevel:    logLine.LogLevel.String(),
+				BackendPid:  logLine.BackendPid,
+				Database:    logLine.Database,
+				Username:    logLine.Username,
+				Application: logLine.Application,
+				Content:     strings.TrimSuffix(logLine.Content, "\n"),
+				Details:     logLine.Details,
+				RelatedPids: logLine.RelatedPids,
+			}
+			if logLine.ParentUUID != uuid.Nil {
+				archived.ParentUUID = logLine.ParentUUID.String()
+			}
+			if logLine.Classification != 0 {
+				archived.Classification = logLine.Classification.String()
+			}
+			err := encoder.Encode(archived)
+			if err != nil {
+				logger.PrintError("Could not encode log line for log archive: %s", err)
+				continue
+			}
+			lines++
+		}
+	}
+	if lines == 0 {
+		return
+	}
+	if !server.LogArchive.Add(buf.Bytes(), lines) {
+		logger.PrintWarning("Log archive queue is full, dropping %d log lines", lines)
+	}
+}
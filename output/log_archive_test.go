@@ -0,0 +1,76 @@
+package output
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestArchiveLogs(t *testing.T) {
+	server := state.MakeServer(config.ServerConfig{
+		SectionName:                    "default",
+		LogArchiveDir:                  t.TempDir(),
+		LogArchiveMaxFileSizeMB:        1,
+		LogArchiveRotateIntervalParsed: time.Hour,
+	}, false)
+	parentUUID := uuid.MustParse("0192b7a0-0000-7000-8000-000000000001")
+	childUUID := uuid.MustParse("0192b7a0-0000-7000-8000-000000000002")
+	occurredAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	logState := state.TransientLogState{
+		LogFiles: []state.LogFile{{
+			LogLines: []state.LogLine{{
+				UUID:           parentUUID,
+				OccurredAt:     occurredAt,
+				LogLevel:       pganalyze_collector.LogLineInformation_ERROR,
+				BackendPid:     42,
+				Database:       "app",
+				Username:       "alice",
+				Content:        "duplicate key value violates unique constraint \"users_pkey\"\n",
+				Classification: pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+			}, {
+				UUID:       childUUID,
+				ParentUUID: parentUUID,
+				OccurredAt: occurredAt,
+				LogLevel:   pganalyze_collector.LogLineInformation_DETAIL,
+				BackendPid: 42,
+				Content:    "Key (id)=([redacted]) already exists.\n",
+				Details:    map[string]interface{}{"constraint": "users_pkey"},
+			}},
+		}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	server.LogArchive.Start(ctx, &wg, &util.Logger{})
+	ArchiveLogs(server, logState, &util.Logger{})
+	cancel()
+	wg.Wait()
+
+	files, err := filepath.Glob(filepath.Join(server.LogArchive.Dir(), "*.ndjson"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("want 1 archive file; got %v (err %v)", files, err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"server":"default","uuid":"0192b7a0-0000-7000-8000-000000000001","occurred_at":"2026-10-18T12:00:00Z","log_level":"ERROR","classification":"UNIQUE_CONSTRAINT_VIOLATION","backend_pid":42,"database":"app","username":"alice","content":"duplicate key value violates unique constraint \"users_pkey\""}
+{"server":"default","uuid":"0192b7a0-0000-7000-8000-000000000002","parent_uuid":"0192b7a0-0000-7000-8000-000000000001","occurred_at":"2026-10-18T12:00:00Z","log_level":"DETAIL","backend_pid":42,"content":"Key (id)=([redacted]) already exists.","details":{"constraint":"users_pkey"}}
+`
+	if string(data) != expected {
+		t.Errorf("unexpected archive content:\n%s\nwant:\n%s", data, expected)
+	}
+	if !strings.HasPrefix(filepath.Base(files[0]), "pganalyze-logs-") {
+		t.Errorf("unexpected archive file name %s", files[0])
+	}
+}
@@ -0,0 +1,419 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// SetupStatusEndpointsForAllServers - Starts the local metrics and health endpoints for servers that have them enabled
+//
+// Servers that share the same listen address are served together, with each
+// metrics sample carrying a "server" label with the config section name. The
+// metrics and health endpoints may also share the same address.
+func SetupStatusEndpointsForAllServers(ctx context.Context, servers []*state.Server, opts state.CollectionOpts, logger *util.Logger) {
+	if opts.TestRun {
+		return
+	}
+
+	var addresses []string
+	metricsServersByAddress := make(map[string][]*state.Server)
+	healthServersByAddress := make(map[string][]*state.Server)
+	addAddress := func(address string) {
+		if metricsServersByAddress[address] == nil && healthServersByAddress[address] == nil {
+			addresses = append(addresses, address)
+		}
+	}
+	for _, server := range servers {
+		if address := server.Config.MetricsListenAddress; address != "" {
+			addAddress(address)
+			metricsServersByAddress[address] = append(metricsServersByAddress[address], server)
+		}
+		if address := server.Config.HealthListenAddress; address != "" {
+			addAddress(address)
+			healthServersByAddress[address] = append(healthServersByAddress[address], server)
+		}
+	}
+
+	for _, address := range addresses {
+		serveMux := http.NewServeMux()
+		if metricsServers := metricsServersByAddress[address]; len(metricsServers) > 0 {
+			logger.PrintVerbose("Serving metrics on http://%s/metrics for %s", address, sectionNames(metricsServers))
+			serveMux.HandleFunc("/metrics", metricsHandler(metricsServers))
+		}
+		if healthServers := healthServersByAddress[address]; len(healthServers) > 0 {
+			logger.PrintVerbose("Serving health checks on http://%s/healthz and http://%s/readyz for %s", address, address, sectionNames(healthServers))
+			serveMux.HandleFunc("/healthz", healthHandler(healthServers, opts.StartedAt, false))
+			serveMux.HandleFunc("/readyz", healthHandler(healthServers, opts.StartedAt, true))
+		}
+		util.GoServeHTTP(ctx, logger, address, serveMux)
+	}
+}
+
+func sectionNames(servers []*state.Server) string {
+	var names []string
+	for _, s := range servers {
+		names = append(names, s.Config.SectionName)
+	}
+	return strings.Join(names, ", ")
+}
+
+func metricsHandler(servers []*state.Server) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var families []util.MetricFamily
+		for _, server := range servers {
+			serverFamilies := append(server.Metrics.Families(), snapshotSpoolMetrics(server)...)
+			serverFamilies = append(serverFamilies, logArchiveMetrics(server)...)
+			families = append(families, withServerLabel(serverFamilies, server.Config.SectionName)...)
+		}
+		w.Header().Set("Content-Type", "application/openmetrics-text; version=1.0.0; charset=utf-8")
+		util.WriteOpenMetrics(w, families)
+	}
+}
+
+func logArchiveMetrics(server *state.Server) []util.MetricFamily {
+	if server.LogArchive == nil {
+		return nil
+	}
+	return []util.MetricFamily{
+		{
+			Name:    "pganalyze_collector_log_archive_dropped_lines",
+			Help:    "Number of log lines that could not be written to the local log archive since the collector started",
+			Type:    util.MetricTypeGauge,
+			Samples: []util.MetricSample{{Value: float64(server.LogArchive.DroppedLines())}},
+		},
+	}
+}
+
+func snapshotSpoolMetrics(server *state.Server) []util.MetricFamily {
+	if server.SnapshotSpool == nil {
+		return nil
+	}
+	count, size, err := server.SnapshotSpool.Stats()
+	if err != nil {
+		return nil
+	}
+	return []util.MetricFamily{
+		{
+			Name:    "pganalyze_collector_snapshot_spool_snapshots",
+			Help:    "Number of snapshots in the spool pending upload",
+			Type:    util.MetricTypeGauge,
+			Samples: []util.MetricSample{{Value: float64(count)}},
+		},
+		{
+			Name:    "pganalyze_collector_snapshot_spool_size_bytes",
+			Help:    "Size of the snapshots in the spool pending upload",
+			Type:    util.MetricTypeGauge,
+			Samples: []util.MetricSample{{Value: float64(size)}},
+		},
+	}
+}
+
+// withServerLabel returns copies of the families with the server label added to each sample
+func withServerLabel(families []util.MetricFamily, sectionName string) []util.MetricFamily {
+	result := make([]util.MetricFamily, 0, len(families))
+	for _, family := range families {
+		labeled := family
+		labeled.Samples = make([]util.MetricSample, 0, len(family.Samples))
+		for _, sample := range family.Samples {
+			labels := map[string]string{"server": sectionName}
+			for key, value := range sample.Labels {
+				labels[key] = value
+			}
+			labeled.Samples = append(labeled.Samples, util.MetricSample{Labels: labels, Value: sample.Value})
+		}
+		result = append(result, labeled)
+	}
+	return result
+}
+
+// UpdateStatisticsMetrics - Updates the statistics served on the local metrics endpoint with the latest full snapshot data
+//
+// Values that are diffed between snapshots are exposed as per-second rates
+// over the last collection interval.
+func UpdateStatisticsMetrics(server *state.Server, newState state.PersistedState, diffState state.DiffState, transientState state.TransientState, collectedIntervalSecs uint32) {
+	if server.Config.MetricsListenAddress == "" {
+		return
+	}
+
+	databaseNames := make(map[state.Oid]string)
+	for _, database := range transientState.Databases {
+		databaseNames[database.Oid] = database.Name
+	}
+
+	var families []util.MetricFamily
+	families = append(families, databaseMetrics(diffState, databaseNames, collectedIntervalSecs)...)
+	families = append(families, relationMetrics(newState, diffState, databaseNames, collectedIntervalSecs)...)
+	families = append(families, statementMetrics(diffState, transientState, databaseNames)...)
+	families = append(families, replicationMetrics(transientState)...)
+	families = append(families, systemMetrics(newState, diffState)...)
+	families = append(families, collectorStatsMetrics(diffState)...)
+	server.Metrics.SetStatistics(families)
+}
+
+func databaseMetrics(diffState state.DiffState, databaseNames map[state.Oid]string, collectedIntervalSecs uint32) []util.MetricFamily {
+	interval := float64(collectedIntervalSecs)
+	xactCommit := util.MetricFamily{Name: "pganalyze_database_xact_commit_per_second", Help: "Transactions committed per second", Type: util.MetricTypeGauge}
+	xactRollback := util.MetricFamily{Name: "pganalyze_database_xact_rollback_per_second", Help: "Transactions rolled back per second", Type: util.MetricTypeGauge}
+	tempFiles := util.MetricFamily{Name: "pganalyze_database_temp_files_per_second", Help: "Temporary files created per second", Type: util.MetricTypeGauge}
+	tempBytes := util.MetricFamily{Name: "pganalyze_database_temp_bytes_per_second", Help: "Bytes written to temporary files per second", Type: util.MetricTypeGauge}
+	frozenXIDAge := util.MetricFamily{Name: "pganalyze_database_frozen_xid_age", Help: "Age of the oldest unfrozen transaction ID in the database", Type: util.MetricTypeGauge}
+	minMXIDAge := util.MetricFamily{Name: "pganalyze_database_min_mxid_age", Help: "Age of the oldest unfrozen multixact ID in the database", Type: util.MetricTypeGauge}
+
+	for _, databaseOid := range sortedOids(diffState.DatabaseStats) {
+		stats := diffState.DatabaseStats[databaseOid]
+		name, ok := databaseNames[databaseOid]
+		if !ok {
+			continue
+		}
+		labels := map[string]string{"database": name}
+		xactCommit.Add(float64(stats.XactCommit)/interval, labels)
+		xactRollback.Add(float64(stats.XactRollback)/interval, labels)
+		tempFiles.Add(float64(stats.TempFiles)/interval, labels)
+		tempBytes.Add(float64(stats.TempBytes)/interval, labels)
+		frozenXIDAge.Add(float64(stats.FrozenXIDAge), labels)
+		minMXIDAge.Add(float64(stats.MinMXIDAge), labels)
+	}
+
+	return []util.MetricFamily{xactCommit, xactRollback, tempFiles, tempBytes, frozenXIDAge, minMXIDAge}
+}
+
+func relationMetrics(newState state.PersistedState, diffState state.DiffState, databaseNames map[state.Oid]string, collectedIntervalSecs uint32) []util.MetricFamily {
+	interval := float64(collectedIntervalSecs)
+	sizeBytes := util.MetricFamily{Name: "pganalyze_relation_size_bytes", Help: "Size of the table (excluding indexes and TOAST)", Type: util.MetricTypeGauge}
+	toastSizeBytes := util.MetricFamily{Name: "pganalyze_relation_toast_size_bytes", Help: "Size of the table's TOAST data", Type: util.MetricTypeGauge}
+	liveTuples := util.MetricFamily{Name: "pganalyze_relation_live_tuples", Help: "Estimated number of live rows", Type: util.MetricTypeGauge}
+	deadTuples := util.MetricFamily{Name: "pganalyze_relation_dead_tuples", Help: "Estimated number of dead rows", Type: util.MetricTypeGauge}
+	seqScans := util.MetricFamily{Name: "pganalyze_relation_seq_scans_per_second", Help: "Sequential scans per second", Type: util.MetricTypeGauge}
+	idxScans := util.MetricFamily{Name: "pganalyze_relation_idx_scans_per_second", Help: "Index scans per second", Type: util.MetricTypeGauge}
+	tupIns := util.MetricFamily{Name: "pganalyze_relation_tuples_inserted_per_second", Help: "Rows inserted per second", Type: util.MetricTypeGauge}
+	tupUpd := util.MetricFamily{Name: "pganalyze_relation_tuples_updated_per_second", Help: "Rows updated per second", Type: util.MetricTypeGauge}
+	tupDel := util.MetricFamily{Name: "pganalyze_relation_tuples_deleted_per_second", Help: "Rows deleted per second", Type: util.MetricTypeGauge}
+
+	for _, relation := range newState.Relations {
+		schemaStats, ok := diffState.SchemaStats[relation.DatabaseOid]
+		if !ok {
+			continue
+		}
+		stats, ok := schemaStats.RelationStats[relation.Oid]
+		if !ok {
+			continue
+		}
+		labels := map[string]string{
+			"database": databaseNames[relation.DatabaseOid],
+			"schema":   relation.SchemaName,
+			"relation": relation.RelationName,
+		}
+		sizeBytes.Add(float64(stats.SizeBytes), labels)
+		toastSizeBytes.Add(float64(stats.ToastSizeBytes), labels)
+		liveTuples.Add(float64(stats.NLiveTup), labels)
+		deadTuples.Add(float64(stats.NDeadTup), labels)
+		seqScans.Add(float64(stats.SeqScan)/interval, labels)
+		idxScans.Add(float64(stats.IdxScan)/interval, labels)
+		tupIns.Add(float64(stats.NTupIns)/interval, labels)
+		tupUpd.Add(float64(stats.NTupUpd)/interval, labels)
+		tupDel.Add(float64(stats.NTupDel)/interval, labels)
+	}
+
+	return []util.MetricFamily{sizeBytes, toastSizeBytes, liveTuples, deadTuples, seqScans, idxScans, tupIns, tupUpd, tupDel}
+}
+
+// statementMetrics aggregates the query statistics of all statements by database
+//
+// The statement statistics may consist of multiple time buckets (when high
+// frequency query statistics are enabled), so rates are based on the total time
+// covered by the buckets, instead of the full snapshot interval.
+func statementMetrics(diffState state.DiffState, transientState state.TransientState, databaseNames map[state.Oid]string) []util.MetricFamily {
+	var intervalSecs uint32
+	totals := make(map[state.Oid]*state.DiffedPostgresStatementStats)
+	for timeKey, statementStats := range transientState.StatementStats {
+		intervalSecs += timeKey.CollectedIntervalSecs
+		for key, stats := range statementStats {
+			total, ok := totals[key.DatabaseOid]
+			if !ok {
+				total = &state.DiffedPostgresStatementStats{}
+				totals[key.DatabaseOid] = total
+			}
+			total.Calls += stats.Calls
+			total.TotalTime += stats.TotalTime
+			total.Rows += stats.Rows
+			total.SharedBlksHit += stats.SharedBlksHit
+			total.SharedBlksRead += stats.SharedBlksRead
+			total.TempBlksWritten += stats.TempBlksWritten
+		}
+	}
+
+	dealloc := util.MetricFamily{Name: "pganalyze_statements_dealloc", Help: "Number of pg_stat_statements entries deallocated since the last snapshot", Type: util.MetricTypeGauge}
+	dealloc.Add(float64(diffState.PgStatStatementsStats.Dealloc), nil)
+	if intervalSecs == 0 {
+		return []util.MetricFamily{dealloc}
+	}
+
+	interval := float64(intervalSecs)
+	calls := util.MetricFamily{Name: "pganalyze_statements_calls_per_second", Help: "Query executions per second", Type: util.MetricTypeGauge}
+	totalTime := util.MetricFamily{Name: "pganalyze_statements_time_seconds_per_second", Help: "Time spent executing queries, in seconds per second", Type: util.MetricTypeGauge}
+	rows := util.MetricFamily{Name: "pganalyze_statements_rows_per_second", Help: "Rows retrieved or affected by queries per second", Type: util.MetricTypeGauge}
+	sharedBlksHit := util.MetricFamily{Name: "pganalyze_statements_shared_blocks_hit_per_second", Help: "Shared buffer hits by queries per second", Type: util.MetricTypeGauge}
+	sharedBlksRead := util.MetricFamily{Name: "pganalyze_statements_shared_blocks_read_per_second", Help: "Shared blocks read by queries per second", Type: util.MetricTypeGauge}
+	tempBlksWritten := util.MetricFamily{Name: "pganalyze_statements_temp_blocks_written_per_second", Help: "Temporary blocks written by queries per second", Type: util.MetricTypeGauge}
+
+	for _, databaseOid := range sortedOids(totals) {
+		total := totals[databaseOid]
+		labels := map[string]string{"database": databaseNames[databaseOid]}
+		calls.Add(float64(total.Calls)/interval, labels)
+		totalTime.Add(total.TotalTime/1000/interval, labels)
+		rows.Add(float64(total.Rows)/interval, labels)
+		sharedBlksHit.Add(float64(total.SharedBlksHit)/interval, labels)
+		sharedBlksRead.Add(float64(total.SharedBlksRead)/interval, labels)
+		tempBlksWritten.Add(float64(total.TempBlksWritten)/interval, labels)
+	}
+
+	return []util.MetricFamily{dealloc, calls, totalTime, rows, sharedBlksHit, sharedBlksRead, tempBlksWritten}
+}
+
+func replicationMetrics(transientState state.TransientState) []util.MetricFamily {
+	replication := transientState.Replication
+	inRecovery := util.MetricFamily{Name: "pganalyze_replication_in_recovery", Help: "Whether the server is a standby (1) or a primary (0)", Type: util.MetricTypeGauge}
+	if replication.InRecovery {
+		inRecovery.Add(1, nil)
+	} else {
+		inRecovery.Add(0, nil)
+	}
+	families := []util.MetricFamily{inRecovery}
+
+	if replication.InRecovery {
+		applyByteLag := util.MetricFamily{Name: "pganalyze_replication_apply_lag_bytes", Help: "Bytes of WAL received but not yet applied on this standby", Type: util.MetricTypeGauge}
+		if replication.ApplyByteLag.Valid {
+			applyByteLag.Add(float64(replication.ApplyByteLag.Int64), nil)
+		}
+		replayAge := util.MetricFamily{Name: "pganalyze_replication_replay_timestamp_age_seconds", Help: "Time since the last replayed transaction was committed on the primary", Type: util.MetricTypeGauge}
+		if replication.ReplayTimestampAge.Valid {
+			replayAge.Add(float64(replication.ReplayTimestampAge.Int64), nil)
+		}
+		return append(families, applyByteLag, replayAge)
+	}
+
+	remoteByteLag := util.MetricFamily{Name: "pganalyze_replication_standby_remote_lag_bytes", Help: "Bytes of WAL not yet replayed by the standby", Type: util.MetricTypeGauge}
+	localByteLag := util.MetricFamily{Name: "pganalyze_replication_standby_local_lag_bytes", Help: "Bytes of WAL not yet sent to the standby", Type: util.MetricTypeGauge}
+	for _, standby := range replication.Standbys {
+		labels := map[string]string{
+			"application_name": standby.ApplicationName,
+			"client_addr":      standby.ClientAddr,
+		}
+		if standby.RemoteByteLag.Valid {
+			remoteByteLag.Add(float64(standby.RemoteByteLag.Int64), labels)
+		}
+		if standby.LocalByteLag.Valid {
+			localByteLag.Add(float64(standby.LocalByteLag.Int64), labels)
+		}
+	}
+	return append(families, remoteByteLag, localByteLag)
+}
+
+func systemMetrics(newState state.PersistedState, diffState state.DiffState) []util.MetricFamily {
+	system := newState.System
+
+	loadavg := util.MetricFamily{Name: "pganalyze_system_load_average", Help: "System load average", Type: util.MetricTypeGauge}
+	loadavg.Add(system.Scheduler.Loadavg1min, map[string]string{"period": "1m"})
+	loadavg.Add(system.Scheduler.Loadavg5min, map[string]string{"period": "5m"})
+	loadavg.Add(system.Scheduler.Loadavg15min, map[string]string{"period": "15m"})
+
+	memory := util.MetricFamily{Name: "pganalyze_system_memory_bytes", Help: "System memory usage", Type: util.MetricTypeGauge}
+	memory.Add(float64(system.Memory.TotalBytes), map[string]string{"type": "total"})
+	memory.Add(float64(system.Memory.AvailableBytes), map[string]string{"type": "available"})
+	memory.Add(float64(system.Memory.FreeBytes), map[string]string{"type": "free"})
+	memory.Add(float64(system.Memory.CachedBytes), map[string]string{"type": "cached"})
+	memory.Add(float64(system.Memory.BuffersBytes), map[string]string{"type": "buffers"})
+	memory.Add(float64(system.Memory.SwapUsedBytes), map[string]string{"type": "swap_used"})
+
+	cpu := util.MetricFamily{Name: "pganalyze_system_cpu_percent", Help: "CPU utilization by mode", Type: util.MetricTypeGauge}
+	for _, cpuID := range sortedKeys(diffState.SystemCPUStats) {
+		stats := diffState.SystemCPUStats[cpuID]
+		cpu.Add(stats.UserPercent, map[string]string{"cpu": cpuID, "mode": "user"})
+		cpu.Add(stats.SystemPercent, map[string]string{"cpu": cpuID, "mode": "system"})
+		cpu.Add(stats.IdlePercent, map[string]string{"cpu": cpuID, "mode": "idle"})
+		cpu.Add(stats.IowaitPercent, map[string]string{"cpu": cpuID, "mode": "iowait"})
+		cpu.Add(stats.StealPercent, map[string]string{"cpu": cpuID, "mode": "steal"})
+	}
+
+	network := util.MetricFamily{Name: "pganalyze_system_network_bytes_per_second", Help: "Network throughput", Type: util.MetricTypeGauge}
+	for _, name := range sortedKeys(diffState.SystemNetworkStats) {
+		stats := diffState.SystemNetworkStats[name]
+		network.Add(float64(stats.ReceiveThroughputBytesPerSecond), map[string]string{"interface": name, "direction": "receive"})
+		network.Add(float64(stats.TransmitThroughputBytesPerSecond), map[string]string{"interface": name, "direction": "transmit"})
+	}
+
+	diskOps := util.MetricFamily{Name: "pganalyze_system_disk_operations_per_second", Help: "Disk I/O operations per second", Type: util.MetricTypeGauge}
+	diskBytes := util.MetricFamily{Name: "pganalyze_system_disk_bytes_per_second", Help: "Disk throughput", Type: util.MetricTypeGauge}
+	diskLatency := util.MetricFamily{Name: "pganalyze_system_disk_latency_seconds", Help: "Average disk I/O latency", Type: util.MetricTypeGauge}
+	diskUtilization := util.MetricFamily{Name: "pganalyze_system_disk_utilization_percent", Help: "Percentage of time the disk was busy", Type: util.MetricTypeGauge}
+	for _, name := range sortedKeys(diffState.SystemDiskStats) {
+		stats := diffState.SystemDiskStats[name]
+		read := map[string]string{"disk": name, "direction": "read"}
+		write := map[string]string{"disk": name, "direction": "write"}
+		diskOps.Add(stats.ReadOperationsPerSecond, read)
+		diskOps.Add(stats.WriteOperationsPerSecond, write)
+		diskBytes.Add(stats.BytesReadPerSecond, read)
+		diskBytes.Add(stats.BytesWrittenPerSecond, write)
+		diskLatency.Add(stats.AvgReadLatency/1000, read)
+		diskLatency.Add(stats.AvgWriteLatency/1000, write)
+		diskUtilization.Add(stats.UtilizationPercent, map[string]string{"disk": name})
+	}
+
+	partitionUsed := util.MetricFamily{Name: "pganalyze_system_partition_used_bytes", Help: "Used space on the disk partition", Type: util.MetricTypeGauge}
+	partitionTotal := util.MetricFamily{Name: "pganalyze_system_partition_total_bytes", Help: "Total space on the disk partition", Type: util.MetricTypeGauge}
+	for _, mountpoint := range sortedKeys(system.DiskPartitions) {
+		partition := system.DiskPartitions[mountpoint]
+		labels := map[string]string{"mountpoint": mountpoint}
+		partitionUsed.Add(float64(partition.UsedBytes), labels)
+		partitionTotal.Add(float64(partition.TotalBytes), labels)
+	}
+
+	walUsed := util.MetricFamily{Name: "pganalyze_system_wal_used_bytes", Help: "Space used by the WAL directory", Type: util.MetricTypeGauge}
+	walUsed.Add(float64(system.XlogUsedBytes), nil)
+
+	return []util.MetricFamily{loadavg, memory, cpu, network, diskOps, diskBytes, diskLatency, diskUtilization, partitionUsed, partitionTotal, walUsed}
+}
+
+func collectorStatsMetrics(diffState state.DiffState) []util.MetricFamily {
+	stats := diffState.CollectorStats
+	heap := util.MetricFamily{Name: "pganalyze_collector_memory_heap_allocated_bytes", Help: "Bytes allocated on the collector's heap", Type: util.MetricTypeGauge}
+	heap.Add(float64(stats.MemoryHeapAllocatedBytes), nil)
+	rss := util.MetricFamily{Name: "pganalyze_collector_memory_rss_bytes", Help: "Resident memory of the collector process", Type: util.MetricTypeGauge}
+	rss.Add(float64(stats.MemoryRssBytes), nil)
+	goroutines := util.MetricFamily{Name: "pganalyze_collector_goroutines", Help: "Number of active goroutines in the collector", Type: util.MetricTypeGauge}
+	goroutines.Add(float64(stats.ActiveGoroutines), nil)
+	logQueueDepth := util.MetricFamily{Name: "pganalyze_collector_log_stream_queue_depth", Help: "Number of streamed log lines waiting to be analyzed", Type: util.MetricTypeGauge}
+	logQueueDepth.Add(float64(stats.LogStreamQueueDepth), nil)
+	logAnalysisLatency := util.MetricFamily{Name: "pganalyze_collector_log_stream_analysis_latency_seconds", Help: "Average time to analyze a batch of streamed log lines since the last snapshot", Type: util.MetricTypeGauge}
+	var logAnalysisLatencySecs float64
+	if stats.LogStreamAnalysisRuns > 0 {
+		logAnalysisLatencySecs = stats.LogStreamAnalysisTimeMs / 1000 / float64(stats.LogStreamAnalysisRuns)
+	}
+	logAnalysisLatency.Add(logAnalysisLatencySecs, nil)
+	logDroppedLines := util.MetricFamily{Name: "pganalyze_collector_log_stream_dropped_lines", Help: "Number of streamed log lines dropped without being sent since the last snapshot", Type: util.MetricTypeGauge}
+	logDroppedLines.Add(float64(stats.LogStreamDroppedLines), nil)
+	return []util.MetricFamily{heap, rss, goroutines, logQueueDepth, logAnalysisLatency, logDroppedLines}
+}
+
+func sortedOids[V any](m map[state.Oid]V) []state.Oid {
+	oids := make([]state.Oid, 0, len(m))
+	for oid := range m {
+		oids = append(oids, oid)
+	}
+	sort.Slice(oids, func(i, j int) bool { return oids[i] < oids[j] })
+	return oids
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
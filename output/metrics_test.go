@@ -0,0 +1,81 @@
+package output
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	first := state.MakeServer(config.ServerConfig{SectionName: "first", MetricsListenAddress: "localhost:0"}, false)
+	second := state.MakeServer(config.ServerConfig{SectionName: "second", MetricsListenAddress: "localhost:0"}, false)
+
+	newState := state.PersistedState{
+		Relations: []state.PostgresRelation{{Oid: 100, DatabaseOid: 1, SchemaName: "public", RelationName: "users"}},
+	}
+	diffState := state.DiffState{
+		DatabaseStats: state.DiffedPostgresDatabaseStatsMap{1: {XactCommit: 120, FrozenXIDAge: 5000}},
+		SchemaStats: map[state.Oid]*state.DiffedSchemaStats{
+			1: {RelationStats: state.DiffedPostgresRelationStatsMap{100: {SizeBytes: 8192, SeqScan: 30}}},
+		},
+	}
+	transientState := state.TransientState{
+		Databases: []state.PostgresDatabase{{Oid: 1, Name: "app"}},
+		StatementStats: state.HistoricStatementStatsMap{
+			{CollectedAt: time.Now(), CollectedIntervalSecs: 60}: {
+				{DatabaseOid: 1, UserOid: 10, QueryID: 1}: {Calls: 480, TotalTime: 1200},
+				{DatabaseOid: 1, UserOid: 10, QueryID: 2}: {Calls: 120, TotalTime: 1800},
+			},
+		},
+	}
+	UpdateStatisticsMetrics(first, newState, diffState, transientState, 60)
+	first.Metrics.RecordRun("full", 2*time.Second, nil)
+	second.Metrics.RecordRun("full", time.Second, errors.New("connection refused"))
+
+	recorder := httptest.NewRecorder()
+	metricsHandler([]*state.Server{first, second})(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/openmetrics-text; version=1.0.0; charset=utf-8" {
+		t.Errorf("Content-Type: want OpenMetrics; got %q", contentType)
+	}
+	body := recorder.Body.String()
+	if !strings.HasSuffix(body, "\n# EOF\n") {
+		t.Errorf("want output to end with # EOF; got %q", body)
+	}
+	for _, expected := range []string{
+		"pganalyze_database_xact_commit_per_second{database=\"app\",server=\"first\"} 2\n",
+		"pganalyze_database_frozen_xid_age{database=\"app\",server=\"first\"} 5000\n",
+		"pganalyze_relation_size_bytes{database=\"app\",relation=\"users\",schema=\"public\",server=\"first\"} 8192\n",
+		"pganalyze_relation_seq_scans_per_second{database=\"app\",relation=\"users\",schema=\"public\",server=\"first\"} 0.5\n",
+		"pganalyze_statements_calls_per_second{database=\"app\",server=\"first\"} 10\n",
+		"pganalyze_statements_time_seconds_per_second{database=\"app\",server=\"first\"} 0.05\n",
+		"pganalyze_replication_in_recovery{server=\"first\"} 0\n",
+		"pganalyze_collector_run_duration_seconds{kind=\"full\",server=\"first\"} 2\n",
+		"pganalyze_collector_runs_succeeded_total{kind=\"full\",server=\"first\"} 1\n",
+		"pganalyze_collector_runs_failed_total{kind=\"full\",server=\"second\"} 1\n",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("want output to contain %q; got:\n%s", expected, body)
+		}
+	}
+
+	// Families shared by both servers are only described once
+	for _, header := range []string{
+		"# TYPE pganalyze_collector_runs_succeeded counter\n",
+		"# HELP pganalyze_collector_runs_succeeded Number of successful collector runs\n",
+	} {
+		if count := strings.Count(body, header); count != 1 {
+			t.Errorf("want %q once; got %d times", header, count)
+		}
+	}
+
+	// Statistics are only collected for servers with a full snapshot
+	if strings.Contains(body, "pganalyze_database_xact_commit_per_second{database=\"app\",server=\"second\"}") {
+		t.Errorf("want no database statistics for second server; got:\n%s", body)
+	}
+}
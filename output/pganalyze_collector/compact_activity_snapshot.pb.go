@@ -1091,6 +1091,10 @@ type CompactActivitySnapshot struct {
 	VacuumProgressInformations []*VacuumProgressInformation `protobuf:"bytes,10,rep,name=vacuum_progress_informations,json=vacuumProgressInformations,proto3" json:"vacuum_progress_informations,omitempty"`
 	VacuumProgressStatistics   []*VacuumProgressStatistic   `protobuf:"bytes,11,rep,name=vacuum_progress_statistics,json=vacuumProgressStatistics,proto3" json:"vacuum_progress_statistics,omitempty"`
 	QueryRuns                  []*QueryRun                  `protobuf:"bytes,12,rep,name=query_runs,json=queryRuns,proto3" json:"query_runs,omitempty"`
+	// Locks involved in lock waits (requested locks not yet granted, and the
+	// granted locks conflicting with them), and the resulting blocking chains
+	Locks     []*Lock     `protobuf:"bytes,13,rep,name=locks,proto3" json:"locks,omitempty"`
+	LockWaits []*LockWait `protobuf:"bytes,14,rep,name=lock_waits,json=lockWaits,proto3" json:"lock_waits,omitempty"`
 }
 
 func (x *CompactActivitySnapshot) Reset() {
@@ -1167,6 +1171,20 @@ func (x *CompactActivitySnapshot) GetQueryRuns() []*QueryRun {
 	return nil
 }
 
+func (x *CompactActivitySnapshot) GetLocks() []*Lock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *CompactActivitySnapshot) GetLockWaits() []*LockWait {
+	if x != nil {
+		return x.LockWaits
+	}
+	return nil
+}
+
 type Backend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1628,6 +1646,244 @@ func (x *VacuumProgressStatistic) GetIndexesProcessed() int64 {
 	return 0
 }
 
+type Lock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid            int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	LockType       string `protobuf:"bytes,2,opt,name=lock_type,json=lockType,proto3" json:"lock_type,omitempty"` // "relation", "tuple", "transactionid", "virtualxid", etc.
+	HasDatabaseIdx bool   `protobuf:"varint,3,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
+	DatabaseIdx    int32  `protobuf:"varint,4,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	// Relations are only referenced by name for the database the collector is
+	// connected to, otherwise only the OID is known
+	HasRelationIdx bool   `protobuf:"varint,5,opt,name=has_relation_idx,json=hasRelationIdx,proto3" json:"has_relation_idx,omitempty"`
+	RelationIdx    int32  `protobuf:"varint,6,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	RelationOid    uint32 `protobuf:"varint,7,opt,name=relation_oid,json=relationOid,proto3" json:"relation_oid,omitempty"`
+	Page           int32  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	Tuple          int32  `protobuf:"varint,9,opt,name=tuple,proto3" json:"tuple,omitempty"`
+	VirtualXid     string `protobuf:"bytes,10,opt,name=virtual_xid,json=virtualXid,proto3" json:"virtual_xid,omitempty"`
+	TransactionId  int64  `protobuf:"varint,11,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Mode           string `protobuf:"bytes,12,opt,name=mode,proto3" json:"mode,omitempty"` // e.g. "AccessExclusiveLock"
+	Granted        bool   `protobuf:"varint,13,opt,name=granted,proto3" json:"granted,omitempty"`
+	Fastpath       bool   `protobuf:"varint,14,opt,name=fastpath,proto3" json:"fastpath,omitempty"`
+}
+
+func (x *Lock) Reset() {
+	*x = Lock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lock) ProtoMessage() {}
+
+func (x *Lock) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lock.ProtoReflect.Descriptor instead.
+func (*Lock) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Lock) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Lock) GetLockType() string {
+	if x != nil {
+		return x.LockType
+	}
+	return ""
+}
+
+func (x *Lock) GetHasDatabaseIdx() bool {
+	if x != nil {
+		return x.HasDatabaseIdx
+	}
+	return false
+}
+
+func (x *Lock) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *Lock) GetHasRelationIdx() bool {
+	if x != nil {
+		return x.HasRelationIdx
+	}
+	return false
+}
+
+func (x *Lock) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *Lock) GetRelationOid() uint32 {
+	if x != nil {
+		return x.RelationOid
+	}
+	return 0
+}
+
+func (x *Lock) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Lock) GetTuple() int32 {
+	if x != nil {
+		return x.Tuple
+	}
+	return 0
+}
+
+func (x *Lock) GetVirtualXid() string {
+	if x != nil {
+		return x.VirtualXid
+	}
+	return ""
+}
+
+func (x *Lock) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Lock) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Lock) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *Lock) GetFastpath() bool {
+	if x != nil {
+		return x.Fastpath
+	}
+	return false
+}
+
+type LockWait struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid           int32   `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	BlockedByPids []int32 `protobuf:"varint,2,rep,packed,name=blocked_by_pids,json=blockedByPids,proto3" json:"blocked_by_pids,omitempty"`
+	// Backends at the start of the blocking chain(s), i.e. those that block others
+	// without waiting on a lock themselves
+	RootBlockerPids []int32 `protobuf:"varint,3,rep,packed,name=root_blocker_pids,json=rootBlockerPids,proto3" json:"root_blocker_pids,omitempty"`
+	// Number of backends between this backend and the furthest root blocker
+	// (1 if directly blocked by a root blocker)
+	ChainDepth int32 `protobuf:"varint,4,opt,name=chain_depth,json=chainDepth,proto3" json:"chain_depth,omitempty"`
+	// Whether the blocking chain contains a cycle (i.e. a deadlock that has not
+	// yet been resolved by the deadlock detector)
+	Deadlock bool `protobuf:"varint,5,opt,name=deadlock,proto3" json:"deadlock,omitempty"`
+}
+
+func (x *LockWait) Reset() {
+	*x = LockWait{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockWait) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockWait) ProtoMessage() {}
+
+func (x *LockWait) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockWait.ProtoReflect.Descriptor instead.
+func (*LockWait) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LockWait) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *LockWait) GetBlockedByPids() []int32 {
+	if x != nil {
+		return x.BlockedByPids
+	}
+	return nil
+}
+
+func (x *LockWait) GetRootBlockerPids() []int32 {
+	if x != nil {
+		return x.RootBlockerPids
+	}
+	return nil
+}
+
+func (x *LockWait) GetChainDepth() int32 {
+	if x != nil {
+		return x.ChainDepth
+	}
+	return 0
+}
+
+func (x *LockWait) GetDeadlock() bool {
+	if x != nil {
+		return x.Deadlock
+	}
+	return false
+}
+
 var File_compact_activity_snapshot_proto protoreflect.FileDescriptor
 
 var file_compact_activity_snapshot_proto_rawDesc = []byte{
@@ -1637,7 +1893,7 @@ var file_compact_activity_snapshot_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x04, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x05, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
 	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
 	0x74, 0x12, 0x4f, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x5f, 0x76, 0x65,
 	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67,
@@ -1670,849 +1926,894 @@ var file_compact_activity_snapshot_proto_rawDesc = []byte{
 	0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18,
 	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
 	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x75, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x73, 0x22,
-	0xfc, 0x60, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73,
-	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72,
-	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
-	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x49, 0x64, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54,
-	0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x78, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74,
-	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79,
-	0x5f, 0x70, 0x69, 0x64, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x50, 0x69, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0xae,
-	0x02, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45,
-	0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x47, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a,
-	0x0c, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12,
-	0x16, 0x0a, 0x12, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45,
-	0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x50,
-	0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10,
-	0x06, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x49,
-	0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b,
-	0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x50, 0x43, 0x10, 0x09, 0x12, 0x13, 0x0a,
-	0x0f, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
-	0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x4f,
-	0x10, 0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x4e,
-	0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x0c, 0x22,
-	0xcc, 0x57, 0x0a, 0x09, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
-	0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d,
-	0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x65, 0x12, 0x22, 0x0a,
-	0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x4f, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x66, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x58, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x67, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f,
-	0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x68, 0x12, 0x27, 0x0a, 0x23,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x69, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6a, 0x12,
-	0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x55, 0x46, 0x5f, 0x4d, 0x41, 0x50,
-	0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6b, 0x12, 0x24, 0x0a, 0x20, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x6c, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6d, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x6e, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6f, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x42,
-	0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x70, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43,
-	0x54, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x71, 0x12, 0x31, 0x0a, 0x2d,
+	0x79, 0x52, 0x75, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x73, 0x12,
+	0x2f, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x3c, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x57,
+	0x61, 0x69, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x73, 0x22, 0xfc,
+	0x60, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x78, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f,
+	0x70, 0x69, 0x64, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x42, 0x79, 0x50, 0x69, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x65, 0x78, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0xae, 0x02,
+	0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16,
+	0x0a, 0x12, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52,
+	0x5f, 0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x47,
+	0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x06,
+	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45,
+	0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x50, 0x43, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f,
+	0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x4f, 0x10,
+	0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x0c, 0x22, 0xcc,
+	0x57, 0x0a, 0x09, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x12,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f,
+	0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x65, 0x12, 0x22, 0x0a, 0x1e,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x46, 0x46,
-	0x53, 0x45, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x72, 0x12,
-	0x31, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f,
-	0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x5f, 0x43, 0x41, 0x43, 0x48,
-	0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x74, 0x12, 0x2c, 0x0a,
-	0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f,
-	0x43, 0x4f, 0x4d, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x75, 0x12, 0x2a, 0x0a, 0x26, 0x57,
+	0x4b, 0x5f, 0x4f, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x66,
+	0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x58, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x67, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x41,
+	0x52, 0x52, 0x41, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x68, 0x12, 0x27, 0x0a, 0x23, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x41, 0x42,
-	0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x77, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45,
-	0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x78, 0x12, 0x2b,
-	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f,
-	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x7a, 0x12, 0x2e, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55,
-	0x55, 0x4d, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x7b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x43, 0x41,
-	0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7c, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45,
-	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x7d, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46,
-	0x59, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7e, 0x12, 0x27, 0x0a,
-	0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7f, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49,
-	0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x36, 0x0a, 0x31, 0x57, 0x41,
+	0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x69, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6a, 0x12, 0x2a,
+	0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x55, 0x46, 0x5f, 0x4d, 0x41, 0x50, 0x50,
+	0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4e,
-	0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x81, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a,
-	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c,
-	0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x82, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57,
+	0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6c,
+	0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6d, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6e,
+	0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6f, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x70,
+	0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54,
+	0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x71, 0x12, 0x31, 0x0a, 0x2d, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43,
-	0x4b, 0x10, 0x83, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52,
-	0x45, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x84, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x46, 0x46, 0x53,
+	0x45, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x72, 0x12, 0x31,
+	0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4d,
+	0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x73, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45,
+	0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x74, 0x12, 0x2c, 0x0a, 0x28,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x75, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
-	0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x85, 0x01, 0x12, 0x38, 0x0a, 0x33, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44,
-	0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x4d,
-	0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x86, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x87, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41,
+	0x54, 0x57, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x41, 0x42, 0x4c,
+	0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x77, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f,
+	0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x78, 0x12, 0x2b, 0x0a,
+	0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49,
+	0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54,
-	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x88, 0x01, 0x12, 0x34, 0x0a, 0x2f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
-	0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x89, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43,
-	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x8a, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
-	0x54, 0x5f, 0x54, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8b, 0x01, 0x12, 0x2e, 0x0a, 0x29,
+	0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x7a, 0x12, 0x2e, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55,
+	0x4d, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x7b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x43, 0x41, 0x4e,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7c, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x7d, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59,
+	0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7e, 0x12, 0x27, 0x0a, 0x23,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52,
-	0x49, 0x47, 0x49, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8c, 0x01, 0x12, 0x31, 0x0a, 0x2c,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55,
-	0x4e, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8d, 0x01, 0x12,
-	0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f,
-	0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x8e, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f,
-	0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8f, 0x01, 0x12, 0x2e,
-	0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x5f,
-	0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x2b,
-	0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x91, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57,
+	0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x7f, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41,
+	0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x48, 0x41, 0x53,
+	0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x36, 0x0a, 0x31, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
+	0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49,
+	0x53, 0x48, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x81,
+	0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41,
+	0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x82, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x83, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45,
+	0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x84, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42,
+	0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x85, 0x01, 0x12, 0x38, 0x0a, 0x33, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x59,
+	0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f,
+	0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x86, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x87, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x88, 0x01, 0x12, 0x34, 0x0a, 0x2f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x89, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x8a, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
+	0x5f, 0x54, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8b, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x57, 0x52, 0x41, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x53, 0x5f, 0x56, 0x41, 0x43,
-	0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb4, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49,
+	0x47, 0x49, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8c, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x54, 0x41,
-	0x49, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb5, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55, 0x4e,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8d, 0x01, 0x12, 0x31,
+	0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8e,
+	0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x52,
+	0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8f, 0x01, 0x12, 0x2e, 0x0a,
+	0x29, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x57,
+	0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x2b, 0x0a,
+	0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x91, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
-	0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb6, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49,
+	0x57, 0x52, 0x41, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x53, 0x5f, 0x56, 0x41, 0x43, 0x55,
+	0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb4, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x54, 0x41, 0x49,
+	0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb5, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
 	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57,
-	0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0xb7, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x52,
-	0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb8, 0x01, 0x12,
-	0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
-	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb9, 0x01, 0x12, 0x2a, 0x0a, 0x25,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xba, 0x01, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x49,
-	0x4f, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53,
-	0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0xbb, 0x01, 0x12, 0x25, 0x0a, 0x20,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
-	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52,
-	0x10, 0x92, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
-	0x49, 0x54, 0x54, 0x53, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x93, 0x01, 0x12, 0x29,
-	0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f,
-	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x94, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x58, 0x41, 0x43, 0x54, 0x4f, 0x46, 0x46, 0x53, 0x45,
-	0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x95, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb6, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41,
+	0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0xb7, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x52, 0x45,
+	0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb8, 0x01, 0x12, 0x2b,
+	0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xb9, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xba, 0x01, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x49, 0x4f,
+	0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0xbb, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57,
 	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x58, 0x41, 0x43, 0x54, 0x4d, 0x45, 0x4d,
-	0x42, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x96, 0x01, 0x12, 0x27, 0x0a,
-	0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
-	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x42, 0x55, 0x46,
-	0x46, 0x45, 0x52, 0x10, 0x97, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53,
-	0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x98, 0x01, 0x12,
-	0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
-	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x53, 0x45,
-	0x52, 0x54, 0x10, 0x99, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55,
-	0x46, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x9a, 0x01, 0x12,
-	0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
-	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x49,
-	0x4f, 0x10, 0x9b, 0x01, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50,
-	0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x9c, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54,
+	0x43, 0x48, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10,
+	0x92, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x54, 0x53, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x93, 0x01, 0x12, 0x29, 0x0a,
+	0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
+	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f, 0x42,
+	0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x94, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54,
 	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f,
-	0x54, 0x5f, 0x49, 0x4f, 0x10, 0x9d, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x50, 0x41, 0x54, 0x48, 0x10, 0x9e, 0x01,
-	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
-	0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x9f, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x58, 0x41, 0x43, 0x54, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x95, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10,
-	0xa0, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49,
-	0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45,
-	0x52, 0x10, 0xa1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52,
-	0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10,
-	0xa2, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c,
-	0x4c, 0x45, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa3, 0x01,
-	0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa4, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0xa5, 0x01, 0x12, 0x33,
-	0x0a, 0x2e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49,
-	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x4d, 0x4f, 0x44,
-	0x10, 0xa6, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52,
-	0x45, 0x44, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0xa7, 0x01,
-	0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f,
-	0x54, 0x49, 0x44, 0x42, 0x49, 0x54, 0x4d, 0x41, 0x50, 0x10, 0xa8, 0x01, 0x12, 0x29, 0x0a, 0x24,
+	0x48, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x58, 0x41, 0x43, 0x54, 0x4d, 0x45, 0x4d, 0x42,
+	0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x96, 0x01, 0x12, 0x27, 0x0a, 0x22,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
-	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50,
-	0x50, 0x45, 0x4e, 0x44, 0x10, 0xa9, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x42, 0x55, 0x46, 0x46,
+	0x45, 0x52, 0x10, 0x97, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45,
+	0x52, 0x49, 0x41, 0x4c, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x98, 0x01, 0x12, 0x24,
+	0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52,
+	0x54, 0x10, 0x99, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46,
+	0x46, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x9a, 0x01, 0x12, 0x23,
+	0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x49, 0x4f,
+	0x10, 0x9b, 0x01, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x10, 0x9c, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f,
 	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
-	0x50, 0x45, 0x52, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41,
-	0x54, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0xaa, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
+	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54,
+	0x5f, 0x49, 0x4f, 0x10, 0x9d, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x50, 0x41, 0x54, 0x48, 0x10, 0x9e, 0x01, 0x12,
+	0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x4d,
+	0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x9f, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
+	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0xa0,
+	0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52,
+	0x10, 0xa1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41,
+	0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0xa2,
+	0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c,
+	0x45, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa3, 0x01, 0x12,
+	0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa4, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x42, 0x54, 0x52, 0x45,
-	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xcd, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
+	0x48, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0xa5, 0x01, 0x12, 0x33, 0x0a,
+	0x2e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
+	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x4d, 0x4f, 0x44, 0x10,
+	0xa6, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45,
+	0x44, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0xa7, 0x01, 0x12,
+	0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x54,
+	0x49, 0x44, 0x42, 0x49, 0x54, 0x4d, 0x41, 0x50, 0x10, 0xa8, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
+	0x43, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50,
+	0x45, 0x4e, 0x44, 0x10, 0xa9, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0xaa, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
 	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xce, 0x08,
-	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0xcf, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x08,
-	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45,
-	0x52, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xd1, 0x08, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54,
+	0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45,
+	0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xcd, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
 	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0xd2,
-	0x08, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x52, 0x45,
-	0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xd3, 0x08, 0x12, 0x2b, 0x0a,
-	0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
-	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
-	0x52, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0xd4, 0x08, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x56, 0x41, 0x43, 0x55,
-	0x55, 0x4d, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xd5, 0x08, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49,
+	0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xce, 0x08, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x10, 0xcf, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
+	0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x08, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52,
+	0x5f, 0x44, 0x53, 0x41, 0x10, 0xd1, 0x08, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
+	0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0xd2, 0x08,
+	0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x52, 0x45, 0x47,
+	0x49, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xd3, 0x08, 0x12, 0x2b, 0x0a, 0x26,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52,
+	0x59, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0xd4, 0x08, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
 	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x5f, 0x41, 0x49, 0x4f, 0x5f, 0x55, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xd6, 0x08, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f,
-	0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc8, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
-	0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e,
-	0x44, 0x10, 0xc9, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10,
-	0xca, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10, 0xcb,
-	0x01, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x56, 0x49, 0x52,
-	0x54, 0x55, 0x41, 0x4c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0xcd, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x55, 0x4c, 0x41,
-	0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xce, 0x01, 0x12, 0x1e, 0x0a,
-	0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x54, 0x41, 0x47, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0xcf, 0x01, 0x12, 0x20, 0x0a,
-	0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x54, 0x41, 0x47, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xd0, 0x01, 0x12,
-	0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x59, 0x10, 0xd1,
+	0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55,
+	0x4d, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xd5, 0x08, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
+	0x5f, 0x41, 0x49, 0x4f, 0x5f, 0x55, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xd6, 0x08, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52,
+	0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc8, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44,
+	0x10, 0xc9, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10, 0xca,
+	0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10, 0xcb, 0x01,
+	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0xcc, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcd,
 	0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x44, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46,
-	0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x53, 0x10, 0xd2, 0x01, 0x12, 0x29, 0x0a, 0x24,
+	0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x55, 0x4c, 0x41, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xce, 0x01, 0x12, 0x1e, 0x0a, 0x19,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54,
-	0x41, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0xd3, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e,
-	0x10, 0xac, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x90, 0x03, 0x12, 0x22,
-	0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10,
-	0x91, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf4,
-	0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10,
-	0xf5, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x42, 0x45, 0x52, 0x4e,
-	0x41, 0x54, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41,
-	0x49, 0x4e, 0x10, 0xf7, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x41,
-	0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf9, 0x03, 0x12, 0x25, 0x0a, 0x20,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43,
-	0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
-	0x10, 0xfa, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfb, 0x03,
-	0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
-	0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41, 0x4c, 0x4c, 0x10,
-	0xfc, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x54,
-	0x52, 0x45, 0x41, 0x4d, 0x10, 0xfd, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x47, 0x45, 0x52, 0x5f,
-	0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfe, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
-	0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e,
-	0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x81, 0x04, 0x12, 0x25, 0x0a, 0x20,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
-	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e,
-	0x10, 0x82, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
-	0x10, 0x83, 0x04, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c,
-	0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x84, 0x04,
-	0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
-	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x53,
-	0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x85, 0x04, 0x12, 0x2d, 0x0a, 0x28, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53,
-	0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x86, 0x04, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d,
-	0x4d, 0x41, 0x52, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0x87, 0x04, 0x12, 0x1b,
-	0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49,
-	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd9, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c,
-	0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
-	0x10, 0xda, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
-	0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xdb, 0x04, 0x12, 0x1f, 0x0a,
-	0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x53, 0x4c, 0x5f,
-	0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0xdc, 0x04, 0x12, 0x27,
-	0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
-	0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x52, 0x54, 0x10, 0xdd, 0x04, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52,
-	0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0xde, 0x04, 0x12, 0x25, 0x0a, 0x20,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53,
-	0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x10, 0xdf, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x47, 0x53, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45,
-	0x52, 0x10, 0xe0, 0x04, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x4e,
-	0x44, 0x42, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0xe1, 0x04, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44,
-	0x4f, 0x57, 0x4e, 0x10, 0xbc, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x50, 0x41, 0x47,
-	0x45, 0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x10, 0xbf, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x47, 0x41,
-	0x54, 0x48, 0x45, 0x52, 0x10, 0xc0, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xc1, 0x05, 0x12, 0x20, 0x0a, 0x1b,
+	0x41, 0x47, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0xcf, 0x01, 0x12, 0x20, 0x0a, 0x1b,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54,
+	0x41, 0x47, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xd0, 0x01, 0x12, 0x20,
+	0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x54, 0x41, 0x47, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01,
+	0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x44, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x52,
+	0x4f, 0x5a, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x53, 0x10, 0xd2, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
+	0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0xd3, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10,
+	0xac, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x90, 0x03, 0x12, 0x22, 0x0a,
+	0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x91,
+	0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf4, 0x03,
+	0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf5,
+	0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x42, 0x45, 0x52, 0x4e, 0x41,
+	0x54, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49,
+	0x4e, 0x10, 0xf7, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f,
+	0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x50,
+	0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf9, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10,
+	0xfa, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfb, 0x03, 0x12,
+	0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0xfc,
+	0x03, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x10, 0xfd, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x4d,
+	0x41, 0x49, 0x4e, 0x10, 0xfe, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x81, 0x04, 0x12, 0x25, 0x0a, 0x20, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10,
+	0x82, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x49, 0x4f, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10,
+	0x83, 0x04, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45,
+	0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x84, 0x04, 0x12,
+	0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x53, 0x59,
+	0x4e, 0x43, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x85, 0x04, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x48,
+	0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x86, 0x04, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d,
+	0x41, 0x52, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0x87, 0x04, 0x12, 0x1b, 0x0a,
+	0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd9, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10,
+	0xda, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xdb, 0x04, 0x12, 0x1f, 0x0a, 0x1a,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x53, 0x4c, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0xdc, 0x04, 0x12, 0x27, 0x0a,
+	0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x10, 0xdd, 0x04, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0xde, 0x04, 0x12, 0x25, 0x0a, 0x20, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45,
+	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10,
+	0xdf, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x47, 0x53, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x10, 0xe0, 0x04, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44,
+	0x42, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0xe1, 0x04, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f,
+	0x57, 0x4e, 0x10, 0xbc, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x55, 0x50, 0x10, 0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x50, 0x41, 0x47, 0x45,
+	0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0xbf, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x47, 0x41, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0xc0, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xc1, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0xc2, 0x05, 0x12, 0x1f, 0x0a,
+	0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0xc3, 0x05, 0x12, 0x23,
+	0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53,
+	0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45,
+	0x10, 0xc4, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4c, 0x45,
+	0x43, 0x54, 0x10, 0xc5, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0xc6, 0x05, 0x12, 0x25, 0x0a, 0x20,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0xc2, 0x05, 0x12, 0x1f,
-	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53,
-	0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0xc3, 0x05, 0x12,
-	0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54,
-	0x45, 0x10, 0xc4, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4c,
-	0x45, 0x43, 0x54, 0x10, 0xc5, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x48, 0x41, 0x53, 0x48, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0xc6, 0x05, 0x12, 0x25, 0x0a,
-	0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
-	0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x45,
-	0x52, 0x10, 0xc7, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54,
-	0x43, 0x48, 0x45, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xc8, 0x05,
-	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53,
-	0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0xc9, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52,
-	0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54,
-	0x10, 0xca, 0x05, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52,
+	0x10, 0xc7, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
 	0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43,
-	0x48, 0x45, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xcb, 0x05, 0x12, 0x2d, 0x0a,
-	0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
-	0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45,
-	0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x05, 0x12, 0x2a, 0x0a, 0x25,
+	0x48, 0x45, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xc8, 0x05, 0x12,
+	0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
+	0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f,
+	0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0xc9, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f,
+	0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10,
+	0xca, 0x05, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48,
+	0x45, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xcb, 0x05, 0x12, 0x2d, 0x0a, 0x28,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4c, 0x4c,
-	0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xcd, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57,
-	0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0xce,
-	0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54,
-	0x53, 0x5f, 0x52, 0x45, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0xcf, 0x05, 0x12, 0x21, 0x0a,
-	0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49,
-	0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x05,
-	0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0xd1, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0xd2, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x45,
-	0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0xd3, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
-	0x45, 0x10, 0xd4, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xd5, 0x05, 0x12, 0x24, 0x0a,
-	0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41,
-	0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x42, 0x49, 0x54, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x43, 0x41, 0x4e,
-	0x10, 0xd6, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd7, 0x05, 0x12,
-	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41,
-	0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xd8, 0x05,
-	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50,
-	0x52, 0x4f, 0x43, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xd9, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0xda,
-	0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47,
-	0x49, 0x4e, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdb, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdc,
-	0x05, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x53, 0x41, 0x46, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0xdd, 0x05,
-	0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x10, 0xde, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0xdf, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50,
-	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xe0, 0x05, 0x12, 0x1c, 0x0a,
-	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x45,
-	0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0xe1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e,
-	0x44, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xe2, 0x05,
-	0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42,
-	0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41,
-	0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0xe3, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48,
-	0x4f, 0x54, 0x10, 0xe4, 0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e,
-	0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45,
-	0x10, 0xe5, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45,
-	0x10, 0xe6, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55,
-	0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0xe7, 0x05, 0x12, 0x1f, 0x0a, 0x1a,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49,
-	0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0xe8, 0x05, 0x12, 0x29, 0x0a,
-	0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43,
-	0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x4d,
-	0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0xe9, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xea, 0x05,
-	0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53,
-	0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xeb, 0x05, 0x12, 0x2c,
-	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53,
-	0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x52,
-	0x45, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xec, 0x05, 0x12, 0x27, 0x0a, 0x22,
+	0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47,
+	0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
+	0x43, 0x41, 0x54, 0x45, 0x10, 0xcd, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f,
+	0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0xce, 0x05,
+	0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53,
+	0x5f, 0x52, 0x45, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0xcf, 0x05, 0x12, 0x21, 0x0a, 0x1c,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43,
-	0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x41,
-	0x54, 0x41, 0x10, 0xed, 0x05, 0x12, 0x33, 0x0a, 0x2e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x52, 0x41,
-	0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0xee, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x58, 0x41,
-	0x43, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xef, 0x05, 0x12, 0x24,
-	0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43,
-	0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e,
-	0x44, 0x10, 0xf0, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41,
-	0x4e, 0x44, 0x10, 0xf1, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52,
-	0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0xf2, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41,
-	0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0xf3, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42,
-	0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0xa0,
-	0x06, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x50, 0x47, 0x5f, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x10, 0xa1, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45,
-	0x52, 0x59, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa2,
-	0x06, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56,
-	0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c,
-	0x10, 0xa3, 0x06, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xa4, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f,
-	0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa5, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa6, 0x06,
-	0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x50, 0x49, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa7, 0x06, 0x12, 0x1f, 0x0a, 0x1a,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55,
-	0x4d, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xa8, 0x06, 0x12, 0x24, 0x0a,
-	0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0xa9, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x84,
-	0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x42, 0x55, 0x46, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x85, 0x07,
-	0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44,
-	0x10, 0x86, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0x87, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x88, 0x07,
-	0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54,
-	0x45, 0x10, 0x89, 0x07, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x8a, 0x07, 0x12,
-	0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
-	0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x8b, 0x07, 0x12,
-	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
-	0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x8c, 0x07,
-	0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
-	0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10,
-	0x8d, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48,
-	0x10, 0x8e, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45,
-	0x44, 0x49, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x8f, 0x07, 0x12, 0x22, 0x0a,
-	0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x90,
-	0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x91,
-	0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x92,
-	0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41,
-	0x54, 0x45, 0x10, 0x93, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x10, 0x94, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x5a, 0x45,
-	0x52, 0x4f, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x95, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52,
-	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x96, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x10, 0x97, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44,
-	0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0x98, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x99, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41,
+	0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x05, 0x12,
+	0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
+	0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0xd1, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x10, 0xd2, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x10, 0xd3, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x10, 0xd4, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xd5, 0x05, 0x12, 0x24, 0x0a, 0x1f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c,
+	0x4c, 0x45, 0x4c, 0x5f, 0x42, 0x49, 0x54, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10,
+	0xd6, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd7, 0x05, 0x12, 0x1f,
+	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52,
+	0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xd8, 0x05, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52,
+	0x4f, 0x43, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0xd9, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0xda, 0x05,
+	0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49,
+	0x4e, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdb, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdc, 0x05,
+	0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x41, 0x46, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0xdd, 0x05, 0x12,
+	0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x10, 0xde, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0xdf, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xe0, 0x05, 0x12, 0x1c, 0x0a, 0x17,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e,
+	0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0xe1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44,
+	0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xe2, 0x05, 0x12,
+	0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x55, 0x50, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0xe3, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f,
+	0x54, 0x10, 0xe4, 0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10,
+	0xe5, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10,
+	0xe6, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0xe7, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56,
+	0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0xe8, 0x05, 0x12, 0x29, 0x0a, 0x24,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0xe9, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xea, 0x05, 0x12,
+	0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
+	0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f,
+	0x52, 0x45, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xeb, 0x05, 0x12, 0x2c, 0x0a,
+	0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x45,
+	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xec, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x10, 0xed, 0x05, 0x12, 0x33, 0x0a, 0x2e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c,
+	0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0xee, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x58, 0x41, 0x43,
+	0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xef, 0x05, 0x12, 0x24, 0x0a,
+	0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
+	0x10, 0xf0, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e,
+	0x44, 0x10, 0xf1, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f,
+	0x45, 0x58, 0x49, 0x54, 0x10, 0xf2, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52,
+	0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0xf3, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0xa0, 0x06,
+	0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50,
+	0x47, 0x5f, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x10, 0xa1, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52,
+	0x59, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa2, 0x06,
+	0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45,
+	0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x10,
+	0xa3, 0x06, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xa4, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x44,
+	0x45, 0x4c, 0x41, 0x59, 0x10, 0xa5, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa6, 0x06, 0x12,
+	0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x50,
+	0x49, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa7, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d,
+	0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xa8, 0x06, 0x12, 0x24, 0x0a, 0x1f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53,
+	0x55, 0x4d, 0x4d, 0x41, 0x52, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0xa9, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x84, 0x07,
+	0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42,
+	0x55, 0x46, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x85, 0x07, 0x12,
+	0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
+	0x86, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0x87, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x88, 0x07, 0x12,
+	0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x10, 0x89, 0x07, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x8a, 0x07, 0x12, 0x1e,
+	0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50,
+	0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x8b, 0x07, 0x12, 0x1f,
+	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50,
+	0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x8c, 0x07, 0x12,
+	0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0x8d,
+	0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10,
+	0x8e, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44,
+	0x49, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x8f, 0x07, 0x12, 0x22, 0x0a, 0x1d,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x90, 0x07,
+	0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x91, 0x07,
+	0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x92, 0x07,
+	0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54,
+	0x45, 0x10, 0x93, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0x94, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x44, 0x53, 0x4d, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x5a, 0x45, 0x52,
+	0x4f, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x95, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41,
 	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9a,
-	0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9b, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
+	0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0x96, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0x97, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44,
+	0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0x98, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x99, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
 	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x52, 0x45, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52,
-	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x9c, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52,
-	0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9d, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f,
-	0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0x9e, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9a, 0x07,
+	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9b, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x52, 0x45, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0x9c, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f,
 	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x10, 0x9f, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xa0, 0x07, 0x12, 0x28, 0x0a, 0x23,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43,
-	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43,
-	0x41, 0x54, 0x45, 0x10, 0xa1, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9d, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52,
+	0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x10, 0x9e, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
 	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa2, 0x07, 0x12, 0x21, 0x0a,
-	0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa3, 0x07,
-	0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
-	0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43,
-	0x10, 0xa4, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0xa5, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55,
-	0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa6, 0x07, 0x12, 0x24, 0x0a, 0x1f,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0xa7, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c,
-	0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa8, 0x07,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
-	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f,
-	0x52, 0x45, 0x41, 0x44, 0x10, 0xa9, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xaa, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0x9f, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xa0, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41,
+	0x54, 0x45, 0x10, 0xa1, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa2, 0x07, 0x12, 0x21, 0x0a, 0x1c,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa3, 0x07, 0x12,
+	0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0xa4, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x10, 0xa5, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46,
+	0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa6, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa7,
+	0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa8, 0x07, 0x12,
+	0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x10, 0xa9, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
 	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xab, 0x07, 0x12, 0x26, 0x0a,
-	0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c,
-	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x57, 0x52, 0x49,
-	0x54, 0x45, 0x10, 0xac, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xad, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xae,
-	0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x53, 0x4c, 0x52, 0x55, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaf, 0x07, 0x12, 0x1a, 0x0a, 0x15,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb1, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb2, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb3, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x10, 0xb4, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53,
-	0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0xb5, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
-	0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb6, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb7, 0x07,
-	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb8, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
-	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb9, 0x07, 0x12, 0x22, 0x0a, 0x1d,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48,
-	0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xba, 0x07,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49,
-	0x54, 0x45, 0x10, 0xbb, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0xbc, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52,
-	0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xbd, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f,
-	0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbe, 0x07, 0x12,
-	0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
-	0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbf, 0x07, 0x12, 0x1d,
+	0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0xaa, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xab, 0x07, 0x12, 0x26, 0x0a, 0x21,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0xac, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0xad, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xae, 0x07,
+	0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4c, 0x52, 0x55, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaf, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0xb0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0xb1, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0xb2, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb3, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0xb4, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb5,
+	0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb6, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb7, 0x07, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0xb8, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xb9, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41,
+	0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xba, 0x07, 0x12,
+	0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57,
+	0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0xbb, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0xbc, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41,
+	0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xbd, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54,
+	0x53, 0x54, 0x52, 0x41, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbe, 0x07, 0x12, 0x1d,
 	0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
-	0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc0, 0x07, 0x12, 0x1e, 0x0a,
-	0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x43, 0x4f, 0x50, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc1, 0x07, 0x12, 0x1d, 0x0a,
+	0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbf, 0x07, 0x12, 0x1d, 0x0a,
 	0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc2, 0x07, 0x12, 0x1e, 0x0a, 0x19,
+	0x43, 0x4f, 0x50, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc0, 0x07, 0x12, 0x1e, 0x0a, 0x19,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43,
+	0x4f, 0x50, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc1, 0x07, 0x12, 0x1d, 0x0a, 0x18,
 	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49,
-	0x4e, 0x49, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc3, 0x07, 0x12, 0x18, 0x0a, 0x13,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0xc4, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc5, 0x07,
-	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
-	0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41,
-	0x53, 0x53, 0x49, 0x47, 0x4e, 0x10, 0xc6, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0xc7, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41,
-	0x52, 0x52, 0x49, 0x45, 0x52, 0x10, 0xc8, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x49, 0x4f, 0x5f, 0x49, 0x4f, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc9, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x49, 0x4f, 0x5f, 0x49, 0x4f,
-	0x5f, 0x55, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x10, 0xca, 0x07,
-	0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x49, 0x4f, 0x5f, 0x49, 0x4f, 0x5f, 0x55, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x58, 0x45, 0x43,
-	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcb, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54,
+	0x4e, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc2, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e,
+	0x49, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc3, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0xc4, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc5, 0x07, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
+	0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x53,
+	0x53, 0x49, 0x47, 0x4e, 0x10, 0xc6, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0xc7, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x52,
+	0x52, 0x49, 0x45, 0x52, 0x10, 0xc8, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x49, 0x4f, 0x5f, 0x49, 0x4f, 0x5f, 0x43, 0x4f, 0x4d,
+	0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc9, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x49, 0x4f, 0x5f, 0x49, 0x4f, 0x5f,
+	0x55, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x10, 0xca, 0x07, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x49,
+	0x4f, 0x5f, 0x49, 0x4f, 0x5f, 0x55, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcb, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xcc, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54,
 	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x42, 0x41, 0x43, 0x4b, 0x55,
-	0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xcc, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
+	0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xcd, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49,
 	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x42, 0x41, 0x43, 0x4b,
-	0x55, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xcd, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x42, 0x41, 0x43,
-	0x4b, 0x55, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xce, 0x07, 0x12, 0x20, 0x0a, 0x1b,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xcf, 0x07, 0x12, 0x1e,
-	0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50,
-	0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x10, 0xd0, 0x07, 0x12, 0x1c,
-	0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x53, 0x4d,
-	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xd1, 0x07, 0x12, 0x24, 0x0a, 0x1f,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10,
-	0xd2, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x10, 0xd3, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd4, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d,
-	0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd5, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55,
-	0x4d, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd6, 0x07, 0x12, 0x1c,
-	0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4f, 0x5f,
-	0x58, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x90, 0x4e, 0x12, 0x22, 0x0a, 0x1d,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52,
-	0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x91, 0x4e,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4d, 0x41,
-	0x49, 0x4e, 0x10, 0x92, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x49, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x53, 0x10, 0x93, 0x4e, 0x22, 0x04, 0x08, 0x64, 0x10, 0x64, 0x22, 0xc1,
-	0x02, 0x0a, 0x19, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
-	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x55, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xce, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xcf, 0x07, 0x12, 0x1e, 0x0a,
+	0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x10, 0xd0, 0x07, 0x12, 0x1c, 0x0a,
+	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x53, 0x4d, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0xd1, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0xd2,
+	0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0xd3, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd4, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41,
+	0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd5, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x4d,
+	0x4d, 0x41, 0x52, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd6, 0x07, 0x12, 0x1c, 0x0a,
+	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x58,
+	0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x90, 0x4e, 0x12, 0x22, 0x0a, 0x1d, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x91, 0x4e, 0x12,
+	0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55,
+	0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x49,
+	0x4e, 0x10, 0x92, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x49, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x10, 0x93, 0x4e, 0x22, 0x04, 0x08, 0x64, 0x10, 0x64, 0x22, 0xc1, 0x02,
+	0x0a, 0x19, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76,
+	0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x61, 0x73,
+	0x74, 0x22, 0xcd, 0x05, 0x0a, 0x17, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x27, 0x0a,
+	0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x63, 0x75,
+	0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62,
+	0x6c, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a,
+	0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x42,
+	0x6c, 0x6b, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x65,
+	0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73,
+	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x61, 0x63, 0x75, 0x75,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x73, 0x12, 0x29, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75,
+	0x6d, 0x44, 0x65, 0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70,
+	0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x56, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49,
+	0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x43, 0x41, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x41,
+	0x43, 0x55, 0x55, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x03, 0x12, 0x11, 0x0a,
+	0x0d, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10, 0x04,
+	0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x11,
+	0x0a, 0x0d, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10,
+	0x06, 0x22, 0xae, 0x03, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x68, 0x61, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x61,
-	0x73, 0x74, 0x22, 0xcd, 0x05, 0x0a, 0x17, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x27,
-	0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x63,
-	0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65,
-	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x5f,
-	0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x73, 0x63, 0x61,
-	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x70,
-	0x42, 0x6c, 0x6b, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x68,
-	0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b,
-	0x73, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x61, 0x63, 0x75,
-	0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x64,
-	0x65, 0x61, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49,
-	0x64, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e,
-	0x75, 0x6d, 0x44, 0x65, 0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a,
-	0x10, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70,
-	0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x64,
-	0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75,
-	0x70, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a,
-	0x11, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x56,
-	0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e,
-	0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x56,
-	0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f, 0x0a,
-	0x0b, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x03, 0x12, 0x11,
-	0x0a, 0x0d, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10,
-	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12,
-	0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50,
-	0x10, 0x06, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x75,
+	0x70, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x75, 0x70, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x78, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x58, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x73, 0x74, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x73, 0x74, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0xad, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f,
+	0x70, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x42, 0x79, 0x50, 0x69, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x50, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2f, 0x70, 0x67, 0x61, 0x6e,
 	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x62,
@@ -2532,7 +2833,7 @@ func file_compact_activity_snapshot_proto_rawDescGZIP() []byte {
 }
 
 var file_compact_activity_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_compact_activity_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_compact_activity_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_compact_activity_snapshot_proto_goTypes = []interface{}{
 	(Backend_WaitEventType)(0),               // 0: pganalyze.collector.Backend.WaitEventType
 	(Backend_WaitEvent)(0),                   // 1: pganalyze.collector.Backend.WaitEvent
@@ -2541,28 +2842,32 @@ var file_compact_activity_snapshot_proto_goTypes = []interface{}{
 	(*Backend)(nil),                          // 4: pganalyze.collector.Backend
 	(*VacuumProgressInformation)(nil),        // 5: pganalyze.collector.VacuumProgressInformation
 	(*VacuumProgressStatistic)(nil),          // 6: pganalyze.collector.VacuumProgressStatistic
-	(*PostgresVersion)(nil),                  // 7: pganalyze.collector.PostgresVersion
-	(*timestamppb.Timestamp)(nil),            // 8: google.protobuf.Timestamp
-	(*QueryRun)(nil),                         // 9: pganalyze.collector.QueryRun
+	(*Lock)(nil),                             // 7: pganalyze.collector.Lock
+	(*LockWait)(nil),                         // 8: pganalyze.collector.LockWait
+	(*PostgresVersion)(nil),                  // 9: pganalyze.collector.PostgresVersion
+	(*timestamppb.Timestamp)(nil),            // 10: google.protobuf.Timestamp
+	(*QueryRun)(nil),                         // 11: pganalyze.collector.QueryRun
 }
 var file_compact_activity_snapshot_proto_depIdxs = []int32{
-	7,  // 0: pganalyze.collector.CompactActivitySnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
+	9,  // 0: pganalyze.collector.CompactActivitySnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
 	4,  // 1: pganalyze.collector.CompactActivitySnapshot.backends:type_name -> pganalyze.collector.Backend
-	8,  // 2: pganalyze.collector.CompactActivitySnapshot.prev_activity_snapshot_at:type_name -> google.protobuf.Timestamp
+	10, // 2: pganalyze.collector.CompactActivitySnapshot.prev_activity_snapshot_at:type_name -> google.protobuf.Timestamp
 	5,  // 3: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_informations:type_name -> pganalyze.collector.VacuumProgressInformation
 	6,  // 4: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_statistics:type_name -> pganalyze.collector.VacuumProgressStatistic
-	9,  // 5: pganalyze.collector.CompactActivitySnapshot.query_runs:type_name -> pganalyze.collector.QueryRun
-	8,  // 6: pganalyze.collector.Backend.backend_start:type_name -> google.protobuf.Timestamp
-	8,  // 7: pganalyze.collector.Backend.xact_start:type_name -> google.protobuf.Timestamp
-	8,  // 8: pganalyze.collector.Backend.query_start:type_name -> google.protobuf.Timestamp
-	8,  // 9: pganalyze.collector.Backend.state_change:type_name -> google.protobuf.Timestamp
-	8,  // 10: pganalyze.collector.VacuumProgressInformation.started_at:type_name -> google.protobuf.Timestamp
-	2,  // 11: pganalyze.collector.VacuumProgressStatistic.phase:type_name -> pganalyze.collector.VacuumProgressStatistic.VacuumPhase
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	11, // 5: pganalyze.collector.CompactActivitySnapshot.query_runs:type_name -> pganalyze.collector.QueryRun
+	7,  // 6: pganalyze.collector.CompactActivitySnapshot.locks:type_name -> pganalyze.collector.Lock
+	8,  // 7: pganalyze.collector.CompactActivitySnapshot.lock_waits:type_name -> pganalyze.collector.LockWait
+	10, // 8: pganalyze.collector.Backend.backend_start:type_name -> google.protobuf.Timestamp
+	10, // 9: pganalyze.collector.Backend.xact_start:type_name -> google.protobuf.Timestamp
+	10, // 10: pganalyze.collector.Backend.query_start:type_name -> google.protobuf.Timestamp
+	10, // 11: pganalyze.collector.Backend.state_change:type_name -> google.protobuf.Timestamp
+	10, // 12: pganalyze.collector.VacuumProgressInformation.started_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: pganalyze.collector.VacuumProgressStatistic.phase:type_name -> pganalyze.collector.VacuumProgressStatistic.VacuumPhase
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_compact_activity_snapshot_proto_init() }
@@ -2620,6 +2925,30 @@ func file_compact_activity_snapshot_proto_init() {
 				return nil
 			}
 		}
+		file_compact_activity_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockWait); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2627,7 +2956,7 @@ func file_compact_activity_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_compact_activity_snapshot_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
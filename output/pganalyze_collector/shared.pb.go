@@ -24,16 +24,30 @@ const (
 type QueryRunType int32
 
 const (
-	QueryRunType_EXPLAIN QueryRunType = 0 // Future sources: REINDEX, CREATE INDEX, DROP INDEX
+	QueryRunType_EXPLAIN QueryRunType = 0
+	// Maintenance commands, only run when the pganalyze.maintenance_role helper
+	// is set up in the target database
+	QueryRunType_ANALYZE      QueryRunType = 1
+	QueryRunType_REINDEX      QueryRunType = 2 // REINDEX INDEX/TABLE CONCURRENTLY
+	QueryRunType_CREATE_INDEX QueryRunType = 3 // CREATE INDEX CONCURRENTLY
+	QueryRunType_DROP_INDEX   QueryRunType = 4 // DROP INDEX CONCURRENTLY
 )
 
 // Enum value maps for QueryRunType.
 var (
 	QueryRunType_name = map[int32]string{
 		0: "EXPLAIN",
+		1: "ANALYZE",
+		2: "REINDEX",
+		3: "CREATE_INDEX",
+		4: "DROP_INDEX",
 	}
 	QueryRunType_value = map[string]int32{
-		"EXPLAIN": 0,
+		"EXPLAIN":      0,
+		"ANALYZE":      1,
+		"REINDEX":      2,
+		"CREATE_INDEX": 3,
+		"DROP_INDEX":   4,
 	}
 )
 
@@ -3203,6 +3217,11 @@ type QueryRun struct {
 	Result     string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
 	Error      string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	BackendPid int32                  `protobuf:"varint,6,opt,name=backend_pid,json=backendPid,proto3" json:"backend_pid,omitempty"`
+	// Progress of long-running maintenance commands (CREATE INDEX and REINDEX)
+	Progress *QueryRunProgress `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Whether the query run was stopped because of a cancellation request (in
+	// which case error contains the details)
+	Cancelled bool `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 }
 
 func (x *QueryRun) Reset() {
@@ -3279,6 +3298,131 @@ func (x *QueryRun) GetBackendPid() int32 {
 	return 0
 }
 
+func (x *QueryRun) GetProgress() *QueryRunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *QueryRun) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type QueryRunProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase           string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	BlocksTotal     int64  `protobuf:"varint,2,opt,name=blocks_total,json=blocksTotal,proto3" json:"blocks_total,omitempty"`
+	BlocksDone      int64  `protobuf:"varint,3,opt,name=blocks_done,json=blocksDone,proto3" json:"blocks_done,omitempty"`
+	TuplesTotal     int64  `protobuf:"varint,4,opt,name=tuples_total,json=tuplesTotal,proto3" json:"tuples_total,omitempty"`
+	TuplesDone      int64  `protobuf:"varint,5,opt,name=tuples_done,json=tuplesDone,proto3" json:"tuples_done,omitempty"`
+	LockersTotal    int64  `protobuf:"varint,6,opt,name=lockers_total,json=lockersTotal,proto3" json:"lockers_total,omitempty"`
+	LockersDone     int64  `protobuf:"varint,7,opt,name=lockers_done,json=lockersDone,proto3" json:"lockers_done,omitempty"`
+	PartitionsTotal int64  `protobuf:"varint,8,opt,name=partitions_total,json=partitionsTotal,proto3" json:"partitions_total,omitempty"`
+	PartitionsDone  int64  `protobuf:"varint,9,opt,name=partitions_done,json=partitionsDone,proto3" json:"partitions_done,omitempty"`
+}
+
+func (x *QueryRunProgress) Reset() {
+	*x = QueryRunProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRunProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRunProgress) ProtoMessage() {}
+
+func (x *QueryRunProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRunProgress.ProtoReflect.Descriptor instead.
+func (*QueryRunProgress) Descriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryRunProgress) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *QueryRunProgress) GetBlocksTotal() int64 {
+	if x != nil {
+		return x.BlocksTotal
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetBlocksDone() int64 {
+	if x != nil {
+		return x.BlocksDone
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetTuplesTotal() int64 {
+	if x != nil {
+		return x.TuplesTotal
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetTuplesDone() int64 {
+	if x != nil {
+		return x.TuplesDone
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetLockersTotal() int64 {
+	if x != nil {
+		return x.LockersTotal
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetLockersDone() int64 {
+	if x != nil {
+		return x.LockersDone
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetPartitionsTotal() int64 {
+	if x != nil {
+		return x.PartitionsTotal
+	}
+	return 0
+}
+
+func (x *QueryRunProgress) GetPartitionsDone() int64 {
+	if x != nil {
+		return x.PartitionsDone
+	}
+	return 0
+}
+
 var File_shared_proto protoreflect.FileDescriptor
 
 var file_shared_proto_rawDesc = []byte{
@@ -3942,7 +4086,7 @@ var file_shared_proto_rawDesc = []byte{
 	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
 	0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x08, 0x51,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x08, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
@@ -3956,13 +4100,44 @@ var file_shared_proto_rawDesc = []byte{
 	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a,
 	0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x50, 0x69, 0x64, 0x2a, 0x1b,
+	0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x50, 0x69, 0x64, 0x12, 0x41,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22,
+	0xcc, 0x02, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x2a, 0x57,
 	0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x42, 0x3b, 0x5a, 0x39, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x7a, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x2f, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x07, 0x45, 0x58, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x49, 0x4e,
+	0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
+	0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x04, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x2f, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3978,7 +4153,7 @@ func file_shared_proto_rawDescGZIP() []byte {
 }
 
 var file_shared_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_shared_proto_goTypes = []interface{}{
 	(QueryRunType)(0),                          // 0: pganalyze.collector.QueryRunType
 	(QueryExplainInformation_ExplainFormat)(0), // 1: pganalyze.collector.QueryExplainInformation.ExplainFormat
@@ -4018,11 +4193,12 @@ var file_shared_proto_goTypes = []interface{}{
 	(*DiskPartitionInformation)(nil),           // 35: pganalyze.collector.DiskPartitionInformation
 	(*DiskPartitionStatistic)(nil),             // 36: pganalyze.collector.DiskPartitionStatistic
 	(*QueryRun)(nil),                           // 37: pganalyze.collector.QueryRun
-	nil,                                        // 38: pganalyze.collector.SystemInformation.ResourceTagsEntry
-	(*timestamppb.Timestamp)(nil),              // 39: google.protobuf.Timestamp
+	(*QueryRunProgress)(nil),                   // 38: pganalyze.collector.QueryRunProgress
+	nil,                                        // 39: pganalyze.collector.SystemInformation.ResourceTagsEntry
+	(*timestamppb.Timestamp)(nil),              // 40: google.protobuf.Timestamp
 }
 var file_shared_proto_depIdxs = []int32{
-	39, // 0: pganalyze.collector.NullTimestamp.value:type_name -> google.protobuf.Timestamp
+	40, // 0: pganalyze.collector.NullTimestamp.value:type_name -> google.protobuf.Timestamp
 	1,  // 1: pganalyze.collector.QueryExplainInformation.explain_format:type_name -> pganalyze.collector.QueryExplainInformation.ExplainFormat
 	2,  // 2: pganalyze.collector.QueryExplainInformation.explain_source:type_name -> pganalyze.collector.QueryExplainInformation.ExplainSource
 	19, // 3: pganalyze.collector.System.system_information:type_name -> pganalyze.collector.SystemInformation
@@ -4044,19 +4220,20 @@ var file_shared_proto_depIdxs = []int32{
 	21, // 19: pganalyze.collector.SystemInformation.amazon_rds:type_name -> pganalyze.collector.SystemInformationAmazonRDS
 	22, // 20: pganalyze.collector.SystemInformation.crunchy_bridge:type_name -> pganalyze.collector.SystemInformationCrunchyBridge
 	23, // 21: pganalyze.collector.SystemInformation.azure:type_name -> pganalyze.collector.SystemInformationAzure
-	38, // 22: pganalyze.collector.SystemInformation.resource_tags:type_name -> pganalyze.collector.SystemInformation.ResourceTagsEntry
-	39, // 23: pganalyze.collector.SystemInformation.boot_time:type_name -> google.protobuf.Timestamp
-	39, // 24: pganalyze.collector.SystemInformationAmazonRDS.latest_restorable_time:type_name -> google.protobuf.Timestamp
-	39, // 25: pganalyze.collector.SystemInformationAmazonRDS.created_at:type_name -> google.protobuf.Timestamp
-	39, // 26: pganalyze.collector.SystemInformationCrunchyBridge.created_at:type_name -> google.protobuf.Timestamp
-	39, // 27: pganalyze.collector.SystemInformationAzure.created_at:type_name -> google.protobuf.Timestamp
-	39, // 28: pganalyze.collector.QueryRun.started_at:type_name -> google.protobuf.Timestamp
-	39, // 29: pganalyze.collector.QueryRun.finished_at:type_name -> google.protobuf.Timestamp
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	39, // 22: pganalyze.collector.SystemInformation.resource_tags:type_name -> pganalyze.collector.SystemInformation.ResourceTagsEntry
+	40, // 23: pganalyze.collector.SystemInformation.boot_time:type_name -> google.protobuf.Timestamp
+	40, // 24: pganalyze.collector.SystemInformationAmazonRDS.latest_restorable_time:type_name -> google.protobuf.Timestamp
+	40, // 25: pganalyze.collector.SystemInformationAmazonRDS.created_at:type_name -> google.protobuf.Timestamp
+	40, // 26: pganalyze.collector.SystemInformationCrunchyBridge.created_at:type_name -> google.protobuf.Timestamp
+	40, // 27: pganalyze.collector.SystemInformationAzure.created_at:type_name -> google.protobuf.Timestamp
+	40, // 28: pganalyze.collector.QueryRun.started_at:type_name -> google.protobuf.Timestamp
+	40, // 29: pganalyze.collector.QueryRun.finished_at:type_name -> google.protobuf.Timestamp
+	38, // 30: pganalyze.collector.QueryRun.progress:type_name -> pganalyze.collector.QueryRunProgress
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_init() }
@@ -4473,6 +4650,18 @@ func file_shared_proto_init() {
 				return nil
 			}
 		}
+		file_shared_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRunProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_shared_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	file_shared_proto_msgTypes[15].OneofWrappers = []interface{}{
@@ -4487,7 +4676,7 @@ func file_shared_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   35,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
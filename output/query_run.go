@@ -12,14 +12,33 @@ import (
 
 func SubmitQueryRunSnapshot(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, query state.QueryRun) {
 	s := &pganalyze_collector.CompactSnapshot{
-		Data: &pganalyze_collector.CompactSnapshot_QueryRunSnapshot{QueryRunSnapshot: &pganalyze_collector.QueryRunSnapshot{QueryRun: &pganalyze_collector.QueryRun{
-			Id:         query.Id,
-			StartedAt:  timestamppb.New(query.StartedAt),
-			FinishedAt: timestamppb.New(query.FinishedAt),
-			Result:     query.Result,
-			Error:      query.Error,
-			BackendPid: int32(query.BackendPid),
-		}}},
+		Data: &pganalyze_collector.CompactSnapshot_QueryRunSnapshot{QueryRunSnapshot: &pganalyze_collector.QueryRunSnapshot{QueryRun: transformQueryRun(query)}},
 	}
 	uploadAndSubmitCompactSnapshot(ctx, s, server, collectionOpts, logger, time.Now())
 }
+
+func transformQueryRun(query state.QueryRun) *pganalyze_collector.QueryRun {
+	q := &pganalyze_collector.QueryRun{
+		Id:         query.Id,
+		StartedAt:  timestamppb.New(query.StartedAt),
+		FinishedAt: timestamppb.New(query.FinishedAt),
+		Result:     query.Result,
+		Error:      query.Error,
+		BackendPid: int32(query.BackendPid),
+		Cancelled:  query.Cancelled,
+	}
+	if query.Progress != nil {
+		q.Progress = &pganalyze_collector.QueryRunProgress{
+			Phase:           query.Progress.Phase,
+			BlocksTotal:     query.Progress.BlocksTotal,
+			BlocksDone:      query.Progress.BlocksDone,
+			TuplesTotal:     query.Progress.TuplesTotal,
+			TuplesDone:      query.Progress.TuplesDone,
+			LockersTotal:    query.Progress.LockersTotal,
+			LockersDone:     query.Progress.LockersDone,
+			PartitionsTotal: query.Progress.PartitionsTotal,
+			PartitionsDone:  query.Progress.PartitionsDone,
+		}
+	}
+	return q
+}
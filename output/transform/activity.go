@@ -106,5 +106,42 @@ func ActivityStateToCompactActivitySnapshot(server *state.Server, activityState
 		}
 	}
 
+	for _, lock := range activityState.Locks {
+		l := snapshot.Lock{
+			Pid:           lock.Pid,
+			LockType:      lock.LockType,
+			RelationOid:   uint32(lock.RelationOid.Int64),
+			Page:          int32(lock.Page.Int64),
+			Tuple:         int32(lock.Tuple.Int64),
+			VirtualXid:    lock.VirtualXid.String,
+			TransactionId: lock.TransactionId.Int64,
+			Mode:          lock.Mode,
+			Granted:       lock.Granted,
+			Fastpath:      lock.Fastpath,
+		}
+
+		if lock.DatabaseName.Valid {
+			l.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, lock.DatabaseName.String)
+			l.HasDatabaseIdx = true
+
+			if lock.SchemaName.Valid && lock.RelationName.Valid {
+				l.RelationIdx, r.RelationReferences = upsertRelationReference(r.RelationReferences, l.DatabaseIdx, lock.SchemaName.String, lock.RelationName.String)
+				l.HasRelationIdx = true
+			}
+		}
+
+		s.Locks = append(s.Locks, &l)
+	}
+
+	for _, lockWait := range activityState.LockWaits {
+		s.LockWaits = append(s.LockWaits, &snapshot.LockWait{
+			Pid:             lockWait.Pid,
+			BlockedByPids:   lockWait.BlockedByPids,
+			RootBlockerPids: lockWait.RootBlockerPids,
+			ChainDepth:      lockWait.ChainDepth,
+			Deadlock:        lockWait.Deadlock,
+		})
+	}
+
 	return s, r
 }
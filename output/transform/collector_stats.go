@@ -14,6 +14,11 @@ func transformCollectorStats(s snapshot.FullSnapshot, newState state.PersistedSt
 		MemoryRssBytes:           diffState.CollectorStats.MemoryRssBytes,
 		ActiveGoroutines:         diffState.CollectorStats.ActiveGoroutines,
 		CgoCalls:                 diffState.CollectorStats.CgoCalls,
+		LogStreamQueueDepth:      diffState.CollectorStats.LogStreamQueueDepth,
+		LogStreamAnalysisRuns:    diffState.CollectorStats.LogStreamAnalysisRuns,
+		LogStreamAnalysisTimeMs:  diffState.CollectorStats.LogStreamAnalysisTimeMs,
+		LogStreamAnalyzedLines:   diffState.CollectorStats.LogStreamAnalyzedLines,
+		LogStreamDroppedLines:    diffState.CollectorStats.LogStreamDroppedLines,
 	}
 	return s
 }
@@ -122,6 +122,8 @@ func transformSystemLogs(server *state.Server, s snapshot.CompactLogSnapshot, r
 				logFileReference.FilterLogSecret = append(logFileReference.FilterLogSecret, snapshot.LogFileReference_OPS_LOG_SECRET)
 			case state.UnidentifiedLogSecret:
 				logFileReference.FilterLogSecret = append(logFileReference.FilterLogSecret, snapshot.LogFileReference_UNIDENTIFIED_LOG_SECRET)
+			case state.CustomLogSecret:
+				logFileReference.FilterLogSecret = append(logFileReference.FilterLogSecret, snapshot.LogFileReference_CUSTOM_LOG_SECRET)
 			}
 		}
 		s.LogFileReferences = append(s.LogFileReferences, logFileReference)
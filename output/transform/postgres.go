@@ -18,10 +18,11 @@ func transformPostgres(s snapshot.FullSnapshot, newState state.PersistedState, d
 	s = transformPostgresVersion(s, transientState)
 	s = transformPostgresConfig(s, transientState)
 	s = transformPostgresServerStats(s, newState, diffState, transientState)
-	s = transformPostgresReplication(s, transientState, roleOidToIdx)
+	s = transformPostgresReplication(s, transientState, roleOidToIdx, databaseOidToIdx)
 	s, queryIDKeyToIdx := transformPostgresStatements(s, newState, diffState, transientState, roleOidToIdx, databaseOidToIdx, server)
 	s = transformPostgresPlans(s, newState, diffState, transientState, queryIDKeyToIdx)
 	s = transformPostgresRelations(s, newState, diffState, databaseOidToIdx, typeOidToIdx, s.ServerStatistic.CurrentXactId)
+	s = transformPostgresPublications(s, transientState, databaseOidToIdx)
 	s = transformPostgresFunctions(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresBackendCounts(s, transientState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresExtensions(s, transientState, databaseOidToIdx)
@@ -252,9 +253,87 @@ func transformPostgresServerStats(s snapshot.FullSnapshot, newState state.Persis
 		s.ServerIoStatistics = append(s.ServerIoStatistics, &stats)
 	}
 
+	for timeKey, diffedStats := range transientState.ServerSharedStats {
+		// Ignore any data older than an hour, same as for I/O statistics above
+		if time.Since(timeKey.CollectedAt).Hours() >= 1 {
+			continue
+		}
+		s.ServerSharedStatistics = append(s.ServerSharedStatistics, transformServerSharedStats(timeKey, diffedStats))
+	}
+
 	return s
 }
 
+func transformServerSharedStats(timeKey state.HistoricStatsTimeKey, diffedStats state.DiffedPostgresServerSharedStats) *snapshot.ServerSharedStatistics {
+	stats := snapshot.ServerSharedStatistics{
+		CollectedAt:   timestamppb.New(timeKey.CollectedAt),
+		CollectedSecs: timeKey.CollectedIntervalSecs,
+	}
+	if w := diffedStats.Wal; w != nil {
+		stats.Wal = &snapshot.WalStatistic{
+			Records:     w.Records,
+			Fpi:         w.Fpi,
+			Bytes:       w.Bytes,
+			BuffersFull: w.BuffersFull,
+			Write:       w.Write,
+			Sync:        w.Sync,
+			WriteTime:   w.WriteTime,
+			SyncTime:    w.SyncTime,
+			StatsReset:  snapshot.NullTimeToNullTimestamp(w.StatsReset),
+		}
+	}
+	if c := diffedStats.Checkpointer; c != nil {
+		stats.Checkpointer = &snapshot.CheckpointerStatistic{
+			NumTimed:           c.NumTimed,
+			NumRequested:       c.NumRequested,
+			NumDone:            c.NumDone,
+			RestartpointsTimed: c.RestartpointsTimed,
+			RestartpointsReq:   c.RestartpointsReq,
+			RestartpointsDone:  c.RestartpointsDone,
+			WriteTime:          c.WriteTime,
+			SyncTime:           c.SyncTime,
+			BuffersWritten:     c.BuffersWritten,
+			SlruWritten:        c.SlruWritten,
+			StatsReset:         snapshot.NullTimeToNullTimestamp(c.StatsReset),
+		}
+	}
+	if b := diffedStats.Bgwriter; b != nil {
+		stats.Bgwriter = &snapshot.BgwriterStatistic{
+			BuffersClean:        b.BuffersClean,
+			MaxwrittenClean:     b.MaxwrittenClean,
+			BuffersAlloc:        b.BuffersAlloc,
+			BuffersBackend:      b.BuffersBackend,
+			BuffersBackendFsync: b.BuffersBackendFsync,
+			StatsReset:          snapshot.NullTimeToNullTimestamp(b.StatsReset),
+		}
+	}
+	if a := diffedStats.Archiver; a != nil {
+		stats.Archiver = &snapshot.ArchiverStatistic{
+			ArchivedCount:    a.ArchivedCount,
+			LastArchivedWal:  &snapshot.NullString{Valid: a.LastArchivedWal.Valid, Value: a.LastArchivedWal.String},
+			LastArchivedTime: snapshot.NullTimeToNullTimestamp(a.LastArchivedTime),
+			FailedCount:      a.FailedCount,
+			LastFailedWal:    &snapshot.NullString{Valid: a.LastFailedWal.Valid, Value: a.LastFailedWal.String},
+			LastFailedTime:   snapshot.NullTimeToNullTimestamp(a.LastFailedTime),
+			StatsReset:       snapshot.NullTimeToNullTimestamp(a.StatsReset),
+		}
+	}
+	for name, sl := range diffedStats.Slru {
+		stats.Slru = append(stats.Slru, &snapshot.SlruStatistic{
+			Name:        name,
+			BlksZeroed:  sl.BlksZeroed,
+			BlksHit:     sl.BlksHit,
+			BlksRead:    sl.BlksRead,
+			BlksWritten: sl.BlksWritten,
+			BlksExists:  sl.BlksExists,
+			Flushes:     sl.Flushes,
+			Truncates:   sl.Truncates,
+			StatsReset:  snapshot.NullTimeToNullTimestamp(sl.StatsReset),
+		})
+	}
+	return &stats
+}
+
 func transformPostgresExtensions(s snapshot.FullSnapshot, transientState state.TransientState, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
 	for _, extension := range transientState.Extensions {
 		info := snapshot.Extension{
@@ -6,7 +6,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func transformPostgresReplication(s snapshot.FullSnapshot, transientState state.TransientState, roleOidToIdx OidToIdx) snapshot.FullSnapshot {
+func transformPostgresReplication(s snapshot.FullSnapshot, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
 	r := transientState.Replication
 	s.Replication = &snapshot.Replication{InRecovery: r.InRecovery}
 
@@ -89,5 +89,135 @@ func transformPostgresReplication(s snapshot.FullSnapshot, transientState state.
 			&stats)
 	}
 
+	for _, slot := range r.ReplicationSlots {
+		info := snapshot.ReplicationSlot{
+			SlotName:                 slot.SlotName,
+			SlotType:                 slot.SlotType,
+			Temporary:                slot.Temporary,
+			Active:                   slot.Active,
+			RestartLsnByteLag:        -1,
+			ConfirmedFlushLsnByteLag: -1,
+			SafeWalSize:              -1,
+			SpillTxns:                slot.SpillTxns,
+			SpillCount:               slot.SpillCount,
+			SpillBytes:               slot.SpillBytes,
+			StreamTxns:               slot.StreamTxns,
+			StreamCount:              slot.StreamCount,
+			StreamBytes:              slot.StreamBytes,
+			TotalTxns:                slot.TotalTxns,
+			TotalBytes:               slot.TotalBytes,
+			StatsReset:               snapshot.NullTimeToNullTimestamp(slot.StatsReset),
+		}
+		if databaseIdx, ok := databaseOidToIdx[slot.DatabaseOid]; ok && slot.DatabaseOid != 0 {
+			info.HasDatabaseIdx = true
+			info.DatabaseIdx = databaseIdx
+		}
+		if slot.Plugin.Valid {
+			info.Plugin = slot.Plugin.String
+		}
+		if slot.ActivePid.Valid {
+			info.ActivePid = int32(slot.ActivePid.Int64)
+		}
+		if slot.RestartLsn.Valid {
+			info.RestartLsn = slot.RestartLsn.String
+		}
+		if slot.ConfirmedFlushLsn.Valid {
+			info.ConfirmedFlushLsn = slot.ConfirmedFlushLsn.String
+		}
+		if slot.RestartLsnByteLag.Valid {
+			info.RestartLsnByteLag = slot.RestartLsnByteLag.Int64
+		}
+		if slot.ConfirmedFlushLsnByteLag.Valid {
+			info.ConfirmedFlushLsnByteLag = slot.ConfirmedFlushLsnByteLag.Int64
+		}
+		if slot.WalStatus.Valid {
+			info.WalStatus = slot.WalStatus.String
+		}
+		if slot.SafeWalSize.Valid {
+			info.SafeWalSize = slot.SafeWalSize.Int64
+		}
+		s.Replication.ReplicationSlots = append(s.Replication.ReplicationSlots, &info)
+	}
+
+	for _, sub := range r.Subscriptions {
+		info := snapshot.Subscription{
+			Name:               sub.Name,
+			Enabled:            sub.Enabled,
+			LastMsgSendTime:    snapshot.NullTimeToNullTimestamp(sub.LastMsgSendTime),
+			LastMsgReceiptTime: snapshot.NullTimeToNullTimestamp(sub.LastMsgReceiptTime),
+			LatestEndTime:      snapshot.NullTimeToNullTimestamp(sub.LatestEndTime),
+			SyncWorkers:        sub.SyncWorkers,
+			ApplyErrorCount:    sub.ApplyErrorCount,
+			SyncErrorCount:     sub.SyncErrorCount,
+			StatsReset:         snapshot.NullTimeToNullTimestamp(sub.StatsReset),
+		}
+		if databaseIdx, ok := databaseOidToIdx[sub.DatabaseOid]; ok {
+			info.HasDatabaseIdx = true
+			info.DatabaseIdx = databaseIdx
+		}
+		if sub.Pid.Valid {
+			info.Pid = int32(sub.Pid.Int64)
+		}
+		if sub.ReceivedLsn.Valid {
+			info.ReceivedLsn = sub.ReceivedLsn.String
+		}
+		if sub.LatestEndLsn.Valid {
+			info.LatestEndLsn = sub.LatestEndLsn.String
+		}
+		s.Replication.Subscriptions = append(s.Replication.Subscriptions, &info)
+	}
+
+	return s
+}
+
+// Publications reference relations, and must be transformed after relations to
+// reuse their references
+func transformPostgresPublications(s snapshot.FullSnapshot, transientState state.TransientState, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	if len(transientState.Replication.Publications) == 0 {
+		return s
+	}
+
+	type relationKey struct {
+		databaseIdx  int32
+		schemaName   string
+		relationName string
+	}
+	relationKeyToIdx := make(map[relationKey]int32)
+	for idx, ref := range s.RelationReferences {
+		relationKeyToIdx[relationKey{ref.DatabaseIdx, ref.SchemaName, ref.RelationName}] = int32(idx)
+	}
+
+	for _, pub := range transientState.Replication.Publications {
+		databaseIdx, ok := databaseOidToIdx[pub.DatabaseOid]
+		if !ok {
+			continue
+		}
+		info := snapshot.Publication{
+			DatabaseIdx: databaseIdx,
+			Name:        pub.Name,
+			AllTables:   pub.AllTables,
+			Insert:      pub.Insert,
+			Update:      pub.Update,
+			Delete:      pub.Delete,
+			Truncate:    pub.Truncate,
+			ViaRoot:     pub.ViaRoot,
+		}
+		for _, table := range pub.Tables {
+			key := relationKey{databaseIdx, table.SchemaName, table.RelationName}
+			relationIdx, ok := relationKeyToIdx[key]
+			if !ok {
+				relationIdx = int32(len(s.RelationReferences))
+				s.RelationReferences = append(s.RelationReferences, &snapshot.RelationReference{
+					DatabaseIdx:  databaseIdx,
+					SchemaName:   table.SchemaName,
+					RelationName: table.RelationName,
+				})
+				relationKeyToIdx[key] = relationIdx
+			}
+			info.RelationIdxs = append(info.RelationIdxs, relationIdx)
+		}
+		s.Replication.Publications = append(s.Replication.Publications, &info)
+	}
+
 	return s
 }
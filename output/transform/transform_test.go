@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/guregu/null"
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/output/transform"
@@ -160,6 +161,64 @@ func TestStatements(t *testing.T) {
 	}
 }
 
+func TestReplication(t *testing.T) {
+	newState := state.PersistedState{
+		CollectedAt: time.Now(),
+		Relations: []state.PostgresRelation{
+			{DatabaseOid: 16384, Oid: 1, SchemaName: "public", RelationName: "orders"},
+		},
+	}
+	transientState := state.TransientState{
+		Databases: []state.PostgresDatabase{{Oid: 16384, Name: "app"}},
+		Replication: state.PostgresReplication{
+			ReplicationSlots: []state.PostgresReplicationSlot{
+				{SlotName: "physical_standby", SlotType: "physical", Active: true, RestartLsnByteLag: null.IntFrom(1024)},
+				{SlotName: "debezium", SlotType: "logical", Plugin: null.StringFrom("pgoutput"), DatabaseOid: 16384, SpillBytes: 4096},
+			},
+			Publications: []state.PostgresPublication{
+				{DatabaseOid: 16384, Name: "cdc", Insert: true, Tables: []state.PostgresPublicationTable{
+					{SchemaName: "public", RelationName: "orders"},
+					{SchemaName: "public", RelationName: "customers"},
+				}},
+				{DatabaseOid: 16384, Name: "everything", AllTables: true},
+			},
+			Subscriptions: []state.PostgresSubscription{
+				{Name: "from_upstream", DatabaseOid: 16384, Enabled: true, Pid: null.IntFrom(4242), ApplyErrorCount: 3},
+			},
+		},
+	}
+
+	actual := transform.StateToSnapshot(newState, state.DiffState{}, transientState, state.MakeServer(config.ServerConfig{}, true))
+
+	slots := actual.Replication.ReplicationSlots
+	if len(slots) != 2 {
+		t.Fatalf("expected 2 replication slots, got %d", len(slots))
+	}
+	if slots[0].HasDatabaseIdx || slots[0].RestartLsnByteLag != 1024 || slots[0].ConfirmedFlushLsnByteLag != -1 || slots[0].SafeWalSize != -1 {
+		t.Errorf("unexpected physical slot: %+v", slots[0])
+	}
+	if !slots[1].HasDatabaseIdx || slots[1].DatabaseIdx != 0 || slots[1].Plugin != "pgoutput" || slots[1].SpillBytes != 4096 || slots[1].RestartLsnByteLag != -1 {
+		t.Errorf("unexpected logical slot: %+v", slots[1])
+	}
+
+	subs := actual.Replication.Subscriptions
+	if len(subs) != 1 || !subs[0].HasDatabaseIdx || subs[0].Pid != 4242 || subs[0].ApplyErrorCount != 3 {
+		t.Errorf("unexpected subscriptions: %+v", subs)
+	}
+
+	pubs := actual.Replication.Publications
+	if len(pubs) != 2 {
+		t.Fatalf("expected 2 publications, got %d", len(pubs))
+	}
+	// The published table that was collected as a relation reuses its reference
+	if !slices.Equal(pubs[0].RelationIdxs, []int32{0, 1}) || len(actual.RelationReferences) != 2 || actual.RelationReferences[1].RelationName != "customers" {
+		t.Errorf("unexpected publication relations: %+v, references: %+v", pubs[0].RelationIdxs, actual.RelationReferences)
+	}
+	if !pubs[1].AllTables || len(pubs[1].RelationIdxs) != 0 {
+		t.Errorf("unexpected all tables publication: %+v", pubs[1])
+	}
+}
+
 type OriginalQueryRef struct {
 	Original    *pganalyze_collector.QueryReference
 	OriginalIdx int32
@@ -26,10 +26,24 @@ func SetupSnapshotUploadForAllServers(ctx context.Context, servers []*state.Serv
 func snapshotUploadForServer(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts) {
 	var compactLogTime time.Time
 	compactLogStats := make(map[string]uint8)
+
+	// Periodically retry spooled snapshots, in case no new snapshots come in
+	var spoolReplay <-chan time.Time
+	if useSnapshotSpool(server, opts) {
+		ticker := time.NewTicker(spoolReplayInterval)
+		defer ticker.Stop()
+		spoolReplay = ticker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-spoolReplay:
+			err := replaySnapshotSpool(ctx, server, logger, opts)
+			if err != nil {
+				logger.PrintVerbose("Could not submit spooled snapshots, will retry later: %s", err)
+			}
 		case s := <-server.FullSnapshotUpload:
 			data, err := proto.Marshal(s)
 			if err != nil {
@@ -37,10 +51,10 @@ func snapshotUploadForServer(ctx context.Context, server *state.Server, logger *
 				continue
 			}
 
-			err = uploadViaWebsocketOrHttp(ctx, server, logger, opts, data, s.SnapshotUuid, s.CollectedAt.AsTime(), false)
+			spooled, err := uploadOrSpool(ctx, server, logger, opts, data, s.SnapshotUuid, s.CollectedAt.AsTime(), false)
 			if err != nil {
 				logger.PrintError("Error uploading snapshot: %s", err)
-			} else if !opts.TestRun {
+			} else if !opts.TestRun && !spooled {
 				logger.PrintInfo("Submitted full snapshot successfully")
 			}
 		case s := <-server.CompactSnapshotUpload:
@@ -50,12 +64,12 @@ func snapshotUploadForServer(ctx context.Context, server *state.Server, logger *
 				continue
 			}
 
-			err = uploadViaWebsocketOrHttp(ctx, server, logger, opts, data, s.SnapshotUuid, s.CollectedAt.AsTime(), false)
+			spooled, err := uploadOrSpool(ctx, server, logger, opts, data, s.SnapshotUuid, s.CollectedAt.AsTime(), false)
 			if err != nil {
 				logger.PrintError("Error uploading snapshot: %s", err)
 				continue
 			}
-			if opts.TestRun {
+			if opts.TestRun || spooled {
 				continue
 			}
 
@@ -92,23 +106,106 @@ func summarizeCounts(counts map[string]uint8) string {
 	return details
 }
 
-func uploadViaWebsocketOrHttp(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts, data []byte, snapshotUUID string, collectedAt time.Time, compactSnapshot bool) error {
+const spoolReplayInterval = 1 * time.Minute
+
+func useSnapshotSpool(server *state.Server, opts state.CollectionOpts) bool {
+	// Test runs should report upload problems directly, instead of deferring them
+	return server.SnapshotSpool != nil && !opts.TestRun
+}
+
+// uploadOrSpool - Uploads the snapshot, or adds it to the snapshot spool (if enabled) when the upload fails
+//
+// Any snapshots already in the spool are submitted first, so the pganalyze API
+// receives snapshots in the order they were collected.
+func uploadOrSpool(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts, data []byte, snapshotUUID string, collectedAt time.Time, compactSnapshot bool) (spooled bool, err error) {
+	compressedData := compressSnapshot(data)
+	if !useSnapshotSpool(server, opts) {
+		return false, uploadViaWebsocketOrHttp(ctx, server, logger, opts, compressedData, snapshotUUID, collectedAt, compactSnapshot)
+	}
+
+	err = replaySnapshotSpool(ctx, server, logger, opts)
+	if err == nil {
+		err = uploadViaWebsocketOrHttp(ctx, server, logger, opts, compressedData, snapshotUUID, collectedAt, compactSnapshot)
+		if err == nil {
+			return false, nil
+		}
+	}
+	if ctx.Err() != nil {
+		return false, err
+	}
+
+	discarded, spoolErr := server.SnapshotSpool.Add(compressedData, snapshotUUID, collectedAt, compactSnapshot)
+	if discarded > 0 {
+		logger.PrintWarning("Snapshot spool is full, discarded %d oldest snapshot(s)", discarded)
+	}
+	if spoolErr != nil {
+		logger.PrintError("Could not add snapshot to spool: %s", spoolErr)
+		return false, err
+	}
+	logger.PrintWarning("Error uploading snapshot, added to spool for retry: %s", err)
+	return true, nil
+}
+
+// replaySnapshotSpool - Submits spooled snapshots oldest first, stopping at the first failure
+func replaySnapshotSpool(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts) error {
+	entries, expired, err := server.SnapshotSpool.List()
+	if expired > 0 {
+		logger.PrintWarning("Discarded %d spooled snapshot(s) older than %s", expired, server.Config.SnapshotSpoolMaxAgeParsed)
+	}
+	if err != nil {
+		return fmt.Errorf("could not read snapshot spool: %s", err)
+	}
+
+	submitted := 0
+	for _, entry := range entries {
+		data, err := server.SnapshotSpool.Read(entry)
+		if err != nil {
+			logger.PrintWarning("Discarding unreadable spooled snapshot %s: %s", entry.Filename, err)
+			server.SnapshotSpool.Remove(entry)
+			continue
+		}
+		err = uploadViaWebsocketOrHttp(ctx, server, logger, opts, data, entry.SnapshotUUID, entry.CollectedAt, entry.Compact)
+		if err != nil {
+			if submitted > 0 {
+				logger.PrintInfo("Submitted %d spooled snapshot(s) successfully, %d remaining", submitted, len(entries)-submitted)
+			}
+			return err
+		}
+		err = server.SnapshotSpool.Remove(entry)
+		if err != nil {
+			return fmt.Errorf("could not remove submitted snapshot from spool: %s", err)
+		}
+		submitted++
+	}
+	if submitted > 0 {
+		logger.PrintInfo("Submitted %d spooled snapshot(s) successfully", submitted)
+	}
+	return nil
+}
+
+func compressSnapshot(data []byte) []byte {
 	var compressedData bytes.Buffer
 	w := zlib.NewWriter(&compressedData)
 	w.Write(data)
 	w.Close()
+	return compressedData.Bytes()
+}
+
+func uploadViaWebsocketOrHttp(ctx context.Context, server *state.Server, logger *util.Logger, opts state.CollectionOpts, compressedData []byte, snapshotUUID string, collectedAt time.Time, compactSnapshot bool) (err error) {
+	defer func() { server.Metrics.RecordSnapshotUpload(err) }()
 
 	if server.WebSocket.Connected() {
 		logger.PrintVerbose("Uploading snapshot to websocket")
-		server.WebSocket.Write <- compressedData.Bytes()
+		server.WebSocket.Write <- compressedData
 	} else if server.Config.APIRequireWebsocket {
 		return errors.New("Error uploading snapshot: WebSocket not connected")
 	} else {
-		s3Location, err := uploadSnapshot(ctx, server.Config.HTTPClientWithRetry, server.Grant.Load(), logger, compressedData.Bytes(), snapshotUUID)
+		var s3Location string
+		s3Location, err = uploadSnapshot(ctx, server.Config.HTTPClientWithRetry, server.Grant.Load(), logger, compressedData, snapshotUUID)
 		if err != nil {
 			return err
 		}
-		submitSnapshot(ctx, server, opts, logger, s3Location, collectedAt, compactSnapshot)
+		return submitSnapshot(ctx, server, opts, logger, s3Location, collectedAt, compactSnapshot)
 	}
 	return nil
 }
@@ -0,0 +1,101 @@
+package output
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestUploadOrSpool(t *testing.T) {
+	var mutex sync.Mutex
+	var submitted []string
+	failing := true
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("maintenance"))
+			return
+		}
+		r.ParseForm()
+		submitted = append(submitted, r.URL.Path+" "+r.Form.Get("collected_at"))
+	}))
+	defer api.Close()
+
+	server := state.MakeServer(config.ServerConfig{
+		SectionName:               "default",
+		APIBaseURL:                api.URL,
+		HTTPClientWithRetry:       api.Client(),
+		SnapshotSpoolDir:          t.TempDir(),
+		SnapshotSpoolMaxSizeMB:    1,
+		SnapshotSpoolMaxAgeParsed: time.Hour,
+	}, false)
+	server.WebSocket = &util.ReconnectingSocket{}
+	server.Grant.Store(&state.Grant{ValidForS3Until: time.Now().Add(time.Hour), LocalDir: t.TempDir() + "/"})
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	ctx := context.Background()
+	opts := state.CollectionOpts{}
+	now := time.Now()
+
+	// Snapshots are spooled while the API is unavailable
+	spooled, err := uploadOrSpool(ctx, server, logger, opts, []byte("first"), "uuid-1", now.Add(-2*time.Minute), false)
+	if err != nil || !spooled {
+		t.Fatalf("first: want spooled without error; got %t, %v", spooled, err)
+	}
+	spooled, err = uploadOrSpool(ctx, server, logger, opts, []byte("second"), "uuid-2", now.Add(-time.Minute), true)
+	if err != nil || !spooled {
+		t.Fatalf("second: want spooled without error; got %t, %v", spooled, err)
+	}
+	if count, _, _ := server.SnapshotSpool.Stats(); count != 2 {
+		t.Fatalf("want 2 spooled snapshots; got %d", count)
+	}
+
+	// Once the API is available again, spooled snapshots are submitted in the
+	// order they were collected, before the current snapshot
+	mutex.Lock()
+	failing = false
+	mutex.Unlock()
+	spooled, err = uploadOrSpool(ctx, server, logger, opts, []byte("third"), "uuid-3", now, false)
+	if err != nil || spooled {
+		t.Fatalf("third: want uploaded without error; got %t, %v", spooled, err)
+	}
+	if count, _, _ := server.SnapshotSpool.Stats(); count != 0 {
+		t.Errorf("want empty spool; got %d snapshots", count)
+	}
+	expected := []string{
+		"/v2/snapshots " + strconv.FormatInt(now.Add(-2*time.Minute).Unix(), 10),
+		"/v2/snapshots/compact " + strconv.FormatInt(now.Add(-time.Minute).Unix(), 10),
+		"/v2/snapshots " + strconv.FormatInt(now.Unix(), 10),
+	}
+	if len(submitted) != len(expected) {
+		t.Fatalf("want %d submissions; got %v", len(expected), submitted)
+	}
+	for idx := range expected {
+		if submitted[idx] != expected[idx] {
+			t.Errorf("submission %d: want %q; got %q", idx, expected[idx], submitted[idx])
+		}
+	}
+
+	// Test runs report upload errors directly
+	mutex.Lock()
+	failing = true
+	mutex.Unlock()
+	spooled, err = uploadOrSpool(ctx, server, logger, state.CollectionOpts{TestRun: true}, []byte("test"), "uuid-4", now, false)
+	if err == nil || spooled {
+		t.Errorf("test run: want error without spooling; got %t, %v", spooled, err)
+	}
+	if count, _, _ := server.SnapshotSpool.Stats(); count != 0 {
+		t.Errorf("test run: want empty spool; got %d snapshots", count)
+	}
+}
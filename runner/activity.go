@@ -29,11 +29,11 @@ func processActivityForServer(ctx context.Context, server *state.Server, opts st
 
 	if server.Config.SkipIfReplica {
 		// This connection gets reused for the activity snapshot collection later
-		connection, err = postgres.EstablishConnection(ctx, server, logger, opts, "")
+		connection, err = postgres.AcquireConnection(ctx, server, logger, opts, "")
 		if err != nil {
 			return newState, false, errors.Wrap(err, "failed to connect to database")
 		}
-		defer connection.Close()
+		defer postgres.ReleaseConnection(server, logger, connection)
 		err = checkReplicaCollectionDisabledWithConn(ctx, server, logger, connection)
 		if err != nil {
 			return newState, false, err
@@ -59,11 +59,11 @@ func processActivityForServer(ctx context.Context, server *state.Server, opts st
 	// N.B.: Without the SkipIfReplica flag, we wait to establish the connection to avoid opening
 	// and closing it for no reason when the grant EnableActivity is not set (e.g., production plans)
 	if connection == nil {
-		connection, err = postgres.EstablishConnection(ctx, server, logger, opts, "")
+		connection, err = postgres.AcquireConnection(ctx, server, logger, opts, "")
 		if err != nil {
 			return newState, false, errors.Wrap(err, "failed to connect to database")
 		}
-		defer connection.Close()
+		defer postgres.ReleaseConnection(server, logger, connection)
 	}
 
 	trackActivityQuerySize, err := postgres.GetPostgresSetting(ctx, connection, "track_activity_query_size")
@@ -91,6 +91,16 @@ func processActivityForServer(ctx context.Context, server *state.Server, opts st
 		return newState, false, errors.Wrap(err, "error collecting pg_stat_vacuum_progress")
 	}
 
+	// Blocking PIDs are only collected when lock collection is enabled, so this
+	// also skips the pg_locks query when no backend is waiting on a lock
+	activity.LockWaits = postgres.GetLockWaits(activity.Backends)
+	if len(activity.LockWaits) > 0 {
+		activity.Locks, err = postgres.GetLocks(ctx, c, connection)
+		if err != nil {
+			return newState, false, errors.Wrap(err, "error collecting pg_locks")
+		}
+	}
+
 	activity.CollectedAt = time.Now()
 
 	err = output.SubmitCompactActivitySnapshot(ctx, server, opts, logger, activity)
@@ -126,6 +136,7 @@ func CollectActivityFromAllServers(ctx context.Context, servers []*state.Server,
 			}
 
 			server.ActivityStateMutex.Lock()
+			startedAt := time.Now()
 			newState, success, err := processActivityForServer(ctx, server, opts, prefixedLogger)
 			if err != nil {
 				server.ActivityStateMutex.Unlock()
@@ -134,6 +145,7 @@ func CollectActivityFromAllServers(ctx context.Context, servers []*state.Server,
 				if err == state.ErrReplicaCollectionDisabled {
 					prefixedLogger.PrintVerbose("All monitoring suspended while server is replica")
 				} else {
+					server.Metrics.RecordRun("activity", time.Since(startedAt), err)
 					allSuccessful = false
 					prefixedLogger.PrintError("Could not collect activity for server: %s", err)
 					if server.Config.ErrorCallback != "" {
@@ -143,6 +155,7 @@ func CollectActivityFromAllServers(ctx context.Context, servers []*state.Server,
 			} else {
 				if success {
 					server.SelfTest.MarkCollectionAspectOk(state.CollectionAspectActivity)
+					server.Metrics.RecordRun("activity", time.Since(startedAt), nil)
 				}
 				server.ActivityPrevState = newState
 				server.ActivityStateMutex.Unlock()
@@ -0,0 +1,159 @@
+package runner
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// How long to wait for further file changes before reading the config again,
+// since editors and Kubernetes volume updates usually touch files multiple times
+const configWatchDebounce = 2 * time.Second
+
+// SetupConfigWatcher - Watches the config file (and files it includes or references)
+// and notifies configChanged once the files changed in a way that results in a
+// different valid configuration, or once referenced certificate or credential
+// files changed (e.g. when db_sslcert was rotated)
+//
+// Invalid configurations are reported, but don't trigger a reload, so the current
+// configuration stays in effect until the problem is fixed. The watcher stops
+// after sending a notification, or once the context is canceled.
+func SetupConfigWatcher(ctx context.Context, configFilename string, conf config.Config, logger *util.Logger, configChanged chan<- struct{}) {
+	files := conf.WatchedFiles(configFilename)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.PrintWarning("Could not watch config file for changes: %s", err)
+		return
+	}
+
+	// Directories are watched instead of the files themselves, so we notice files
+	// being replaced (e.g. by editors, or Kubernetes updating a mounted ConfigMap
+	// through a symlink swap), as well as newly included files
+	dirs := make(map[string]bool)
+	for _, file := range files {
+		dirs[filepath.Dir(file)] = true
+	}
+	if conf.IncludePattern != "" {
+		dirs[filepath.Dir(conf.IncludePattern)] = true
+	}
+	for dir := range dirs {
+		err = watcher.Add(dir)
+		if err != nil {
+			logger.PrintWarning("Could not watch directory \"%s\" for config changes: %s", dir, err)
+		}
+	}
+
+	fingerprint := configFingerprint(files, conf.IncludePattern)
+	credentialFingerprint := configFingerprint(conf.CredentialFiles(), "")
+
+	go func() {
+		defer watcher.Close()
+		var debounce <-chan time.Time
+		for {
+			select {
+			case event := <-watcher.Events:
+				if isRelevantConfigEvent(event.Name, files, conf.IncludePattern) {
+					debounce = time.After(configWatchDebounce)
+				}
+			case err := <-watcher.Errors:
+				logger.PrintWarning("Config file watcher failure: %s", err)
+			case <-debounce:
+				debounce = nil
+				newFingerprint := configFingerprint(files, conf.IncludePattern)
+				if newFingerprint == fingerprint {
+					continue
+				}
+				fingerprint = newFingerprint
+
+				newConf, err := config.Read(false, logger, configFilename)
+				if err != nil {
+					logger.PrintError("Config file changed, but the new configuration is invalid, keeping the current configuration: %s", err)
+					continue
+				}
+				// The configuration is read again when reloading, so this one is only
+				// used for comparison
+				diff := config.Diff(conf, newConf)
+				newConf.RemoveTempFiles()
+				credentialsChanged := configFingerprint(conf.CredentialFiles(), "") != credentialFingerprint
+				if diff.Empty() && !credentialsChanged {
+					logger.PrintVerbose("Config file changed, but server configuration is unchanged, not reloading")
+					continue
+				}
+				if diff.Empty() {
+					logger.PrintInfo("Certificate or credential files referenced by the config changed")
+				} else {
+					logConfigDiff(diff, logger)
+				}
+				select {
+				case configChanged <- struct{}{}:
+				default:
+				}
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func isRelevantConfigEvent(name string, files []string, includePattern string) bool {
+	if slices.Contains(files, name) {
+		return true
+	}
+	// Kubernetes updates mounted volumes by swapping the "..data" symlink
+	if strings.HasPrefix(filepath.Base(name), "..") {
+		return true
+	}
+	if includePattern != "" {
+		matched, _ := filepath.Match(includePattern, name)
+		return matched
+	}
+	return false
+}
+
+// Summarizes the contents of the watched files, as well as the set of included
+// files, to avoid reading the config again when nothing actually changed
+func configFingerprint(files []string, includePattern string) string {
+	allFiles := slices.Clone(files)
+	if includePattern != "" {
+		included, _ := filepath.Glob(includePattern)
+		allFiles = append(allFiles, included...)
+	}
+	slices.Sort(allFiles)
+	allFiles = slices.Compact(allFiles)
+
+	h := sha256.New()
+	for _, file := range allFiles {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(h, "%s:error:%s\n", file, err)
+			continue
+		}
+		fmt.Fprintf(h, "%s:%d:", file, len(content))
+		h.Write(content)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func logConfigDiff(diff config.ConfigDiff, logger *util.Logger) {
+	logger.PrintInfo("Config file changed:")
+	for _, sectionName := range diff.Added {
+		logger.PrintInfo("  Added server section [%s]", sectionName)
+	}
+	for _, sectionName := range diff.Removed {
+		logger.PrintInfo("  Removed server section [%s]", sectionName)
+	}
+	for _, change := range diff.Changed {
+		logger.PrintInfo("  Changed server section [%s]: %s", change.SectionName, strings.Join(change.Settings, ", "))
+	}
+}
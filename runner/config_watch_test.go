@@ -0,0 +1,122 @@
+package runner
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestConfigWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "pganalyze-collector.conf")
+	passwordFilename := filepath.Join(dir, "db_password")
+	writeFile := func(filename string, content string) {
+		err := os.WriteFile(filename, []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile(passwordFilename, "secret")
+	writeFile(filename, "[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_password_file = "+passwordFilename+"\n")
+
+	conf, err := config.Read(false, logger, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configChanged := make(chan struct{}, 1)
+	SetupConfigWatcher(ctx, filename, conf, logger, configChanged)
+
+	expectNoChange := func() {
+		select {
+		case <-configChanged:
+			t.Fatal("want no config change notification")
+		case <-time.After(configWatchDebounce + time.Second):
+		}
+	}
+
+	// Changes that don't affect the configuration, or result in an invalid one, are ignored
+	writeFile(filename, "[pganalyze]\napi_key = abc\n\n# Primary database\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_password_file = "+passwordFilename+"\n")
+	expectNoChange()
+	writeFile(filename, "[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_password_file = "+passwordFilename+"\ndb_secret_provider = unknown\n")
+	expectNoChange()
+	writeFile(filename, "[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_password_file = "+passwordFilename+"\n")
+	expectNoChange()
+
+	// Changes to referenced files are picked up
+	writeFile(passwordFilename, "rotated")
+	select {
+	case <-configChanged:
+	case <-time.After(configWatchDebounce + 5*time.Second):
+		t.Fatal("want config change notification")
+	}
+}
+
+func TestConfigWatcherCredentialFiles(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "pganalyze-collector.conf")
+	certFilename := filepath.Join(dir, "client.crt")
+	err := os.WriteFile(certFilename, []byte("cert"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filename, []byte("[pganalyze]\napi_key = abc\n\n[main]\ndb_host = db.example.com\ndb_name = app\ndb_sslcert = "+certFilename+"\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf, err := config.Read(false, logger, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configChanged := make(chan struct{}, 1)
+	SetupConfigWatcher(ctx, filename, conf, logger, configChanged)
+
+	// The certificate path stays the same, but a rotated certificate requires new connections
+	err = os.WriteFile(certFilename, []byte("rotated"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-configChanged:
+	case <-time.After(configWatchDebounce + 5*time.Second):
+		t.Fatal("want config change notification")
+	}
+}
+
+func TestIsRelevantConfigEvent(t *testing.T) {
+	files := []string{"/etc/pganalyze-collector.conf", "/run/secrets/db_password"}
+	pattern := "/etc/pganalyze-collector.d/*.conf"
+
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{"/etc/pganalyze-collector.conf", true},
+		{"/run/secrets/db_password", true},
+		{"/run/secrets/..data", true},
+		{"/etc/pganalyze-collector.d/new.conf", true},
+		{"/etc/pganalyze-collector.d/new.conf.swp", false},
+		{"/etc/hosts", false},
+	}
+	for _, test := range tests {
+		actual := isRelevantConfigEvent(test.name, files, pattern)
+		if actual != test.expected {
+			t.Errorf("%s: want %t; got %t", test.name, test.expected, actual)
+		}
+	}
+}
@@ -53,6 +53,7 @@ func collectDiffAndSubmit(ctx context.Context, server *state.Server, opts state.
 	}
 
 	diffState := diffState(logger, server.PrevState, newState, collectedIntervalSecs)
+	output.UpdateStatisticsMetrics(server, newState, diffState, transientState, collectedIntervalSecs)
 
 	err = output.SendFull(ctx, server, opts, logger, newState, diffState, transientState, collectedIntervalSecs)
 	if err != nil {
@@ -156,6 +157,7 @@ func CollectAllServers(ctx context.Context, servers []*state.Server, opts state.
 			}
 
 			server.StateMutex.Lock()
+			startedAt := time.Now()
 			newState, newCollectionStatus, err := processServer(ctx, server, opts, prefixedLogger)
 			if err != nil {
 				server.StateMutex.Unlock()
@@ -163,6 +165,7 @@ func CollectAllServers(ctx context.Context, servers []*state.Server, opts state.
 				if err == state.ErrReplicaCollectionDisabled {
 					prefixedLogger.PrintVerbose("All monitoring suspended while server is replica")
 				} else {
+					server.Metrics.RecordRun("full", time.Since(startedAt), err)
 					allSuccessful = false
 					prefixedLogger.PrintError("Could not process server: %s", err)
 
@@ -178,6 +181,7 @@ func CollectAllServers(ctx context.Context, servers []*state.Server, opts state.
 					}
 				}
 			} else {
+				server.Metrics.RecordRun("full", time.Since(startedAt), nil)
 				server.PrevState = newState
 				server.StateMutex.Unlock()
 				server.CollectionStatusMutex.Lock()
@@ -20,11 +20,11 @@ func gather1minStatsForServer(ctx context.Context, server *state.Server, opts st
 	newState := server.HighFreqPrevState
 	collectedAt := time.Now()
 
-	connection, err = postgres.EstablishConnection(ctx, server, logger, opts, "")
+	connection, err = postgres.AcquireConnection(ctx, server, logger, opts, "")
 	if err != nil {
 		return newState, errors.Wrap(err, "failed to connect to database")
 	}
-	defer connection.Close()
+	defer postgres.ReleaseConnection(server, logger, connection)
 
 	if server.Config.SkipIfReplica {
 		err = checkReplicaCollectionDisabledWithConn(ctx, server, logger, connection)
@@ -54,6 +54,7 @@ func Gather1minStatsFromAllServers(ctx context.Context, servers []*state.Server,
 			prefixedLogger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
 
 			server.HighFreqStateMutex.Lock()
+			startedAt := time.Now()
 			newState, err := gather1minStatsForServer(ctx, server, opts, prefixedLogger)
 
 			if err != nil {
@@ -62,12 +63,14 @@ func Gather1minStatsFromAllServers(ctx context.Context, servers []*state.Server,
 				if err == state.ErrReplicaCollectionDisabled {
 					prefixedLogger.PrintVerbose("All monitoring suspended while server is replica")
 				} else {
+					server.Metrics.RecordRun("query_stats", time.Since(startedAt), err)
 					prefixedLogger.PrintError("Could not collect high frequency statistics for server: %s", err)
 					if server.Config.ErrorCallback != "" {
 						go runCompletionCallback("error", server.Config.ErrorCallback, server.Config.SectionName, "query_stats", err, prefixedLogger)
 					}
 				}
 			} else {
+				server.Metrics.RecordRun("query_stats", time.Since(startedAt), nil)
 				server.HighFreqPrevState = newState
 				server.HighFreqStateMutex.Unlock()
 				prefixedLogger.PrintVerbose("Successfully collected high frequency statistics")
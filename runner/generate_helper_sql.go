@@ -63,3 +63,49 @@ func GenerateExplainAnalyzeHelperSql(ctx context.Context, server *state.Server,
 
 	return output.String(), nil
 }
+
+func GenerateMaintenanceHelperSql(ctx context.Context, server *state.Server, opts state.CollectionOpts, logger *util.Logger) (string, error) {
+	db, err := postgres.EstablishConnection(ctx, server, logger, opts, "")
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	databases, _, err := postgres.GetDatabases(ctx, db)
+	if err != nil {
+		return "", fmt.Errorf("error collecting pg_databases: %s", err)
+	}
+
+	role := pq.QuoteIdentifier(opts.GenerateMaintenanceHelperRole)
+	username := pq.QuoteIdentifier(server.Config.GetEffectiveDbUsername())
+
+	output := strings.Builder{}
+	output.WriteString(fmt.Sprintf("-- Maintenance commands run as %s, which needs to own the tables (or be a member of their owner).\n", role))
+	output.WriteString(fmt.Sprintf("-- ANALYZE runs through the pganalyze.analyze_table helper, without %s being able to use this role.\n", username))
+	output.WriteString("--\n")
+	output.WriteString("-- CREATE INDEX, REINDEX and DROP INDEX CONCURRENTLY can't run inside helper functions, and instead\n")
+	output.WriteString(fmt.Sprintf("-- require %s to SET ROLE %s. To allow running these commands from pganalyze,\n", username, role))
+	output.WriteString("-- uncomment the GRANT below.\n")
+	output.WriteString("--\n")
+	output.WriteString(fmt.Sprintf("-- WARNING: This grants %s all privileges of %s, for any query and at any time -\n", username, role))
+	output.WriteString("-- including reading, modifying and dropping the tables it owns. The pganalyze.maintenance_role()\n")
+	output.WriteString("-- helper only tells the collector which role to use, and does not restrict what the role can be used for.\n")
+	output.WriteString("-- Only grant this if you trust the monitoring user (and anyone with its credentials) to act as table owner.\n")
+	output.WriteString(fmt.Sprintf("-- GRANT %s TO %s;\n", role, username))
+	output.WriteString("\n")
+	for _, dbName := range postgres.GetDatabasesToCollect(server.Config, databases) {
+		output.WriteString(fmt.Sprintf("\\c %s\n", pq.QuoteIdentifier(dbName)))
+		output.WriteString("CREATE SCHEMA IF NOT EXISTS pganalyze;\n")
+		output.WriteString(fmt.Sprintf("GRANT USAGE ON SCHEMA pganalyze TO %s;\n", username))
+		output.WriteString(fmt.Sprintf("GRANT CREATE ON SCHEMA pganalyze TO %s;\n", role))
+		output.WriteString(fmt.Sprintf("SET ROLE %s;\n", role))
+		output.WriteString(util.MaintenanceRoleHelper + "\n")
+		output.WriteString("RESET ROLE;\n")
+		output.WriteString("REVOKE ALL ON FUNCTION pganalyze.analyze_table(regclass, text[]) FROM PUBLIC;\n")
+		output.WriteString(fmt.Sprintf("GRANT EXECUTE ON FUNCTION pganalyze.analyze_table(regclass, text[]) TO %s;\n", username))
+		output.WriteString(fmt.Sprintf("REVOKE CREATE ON SCHEMA pganalyze FROM %s;\n", role))
+		output.WriteString("\n")
+	}
+
+	return output.String(), nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pganalyze/collector/input/system/azure"
 	"github.com/pganalyze/collector/input/system/google_cloudsql"
 	"github.com/pganalyze/collector/input/system/heroku"
+	"github.com/pganalyze/collector/input/system/kafka"
 	"github.com/pganalyze/collector/input/system/selfhosted"
 	"github.com/pganalyze/collector/input/system/tembo"
 	"github.com/pganalyze/collector/logs"
@@ -33,25 +34,36 @@ const LogStreamingInterval time.Duration = 10 * time.Second
 func SetupLogCollection(ctx context.Context, wg *sync.WaitGroup, servers []*state.Server, opts state.CollectionOpts, logger *util.Logger, hasAnyHeroku bool, hasAnyGoogleCloudSQL bool, hasAnyAzureDatabase bool, hasAnyTembo bool) {
 	var hasAnyLogDownloads bool
 	var hasAnyLogTails bool
+	var hasAnyKafka bool
 
 	for _, server := range servers {
+		// Started regardless of the server being paused, since it may get unpaused
+		// later on, and log lines would be dropped without the writer running
+		if server.LogArchive != nil {
+			server.LogArchive.Start(ctx, wg, logger.WithPrefix(server.Config.SectionName))
+		}
 		if server.Config.DisableLogs || server.Pause.Load() {
 			continue
 		}
 		if server.Config.LogLocation != "" || server.Config.LogDockerTail != "" || server.Config.LogSyslogServer != "" || server.Config.LogOtelServer != "" {
 			hasAnyLogTails = true
+		} else if server.Config.LogKafkaTopic != "" {
+			hasAnyKafka = true
 		} else if server.Config.SupportsLogDownload() {
 			hasAnyLogDownloads = true
 		}
 	}
 
 	var parsedLogStream chan state.ParsedLogStreamItem
-	if hasAnyLogTails || hasAnyHeroku || hasAnyGoogleCloudSQL || hasAnyAzureDatabase || hasAnyTembo {
+	if hasAnyLogTails || hasAnyKafka || hasAnyHeroku || hasAnyGoogleCloudSQL || hasAnyAzureDatabase || hasAnyTembo {
 		parsedLogStream = setupLogStreamer(ctx, wg, opts, logger, servers, nil, stream.LogTestNone)
 	}
 	if hasAnyLogTails {
 		selfhosted.SetupLogTails(ctx, wg, opts, logger, servers, parsedLogStream)
 	}
+	if hasAnyKafka {
+		kafka.SetupLogConsumer(ctx, wg, opts, logger, servers, parsedLogStream)
+	}
 	if hasAnyHeroku {
 		heroku.SetupHttpHandlerLogs(ctx, wg, opts, logger, servers, parsedLogStream)
 		for _, server := range servers {
@@ -172,6 +184,14 @@ func downloadLogsForServer(ctx context.Context, server *state.Server, opts state
 	if err != nil {
 		return newLogState, false, errors.Wrap(err, "could not collect logs")
 	}
+	for _, logFile := range transientLogState.LogFiles {
+		// Downloads regularly succeed without any new log lines, which should not
+		// count as logs being received
+		if len(logFile.LogLines) > 0 {
+			server.Metrics.RecordLogsReceived(transientLogState.CollectedAt)
+			break
+		}
+	}
 
 	err = postprocessAndSendLogs(ctx, server, opts, logger, transientLogState)
 	if err != nil {
@@ -221,8 +241,17 @@ func setupLogStreamer(ctx context.Context, wg *sync.WaitGroup, opts state.Collec
 						continue
 					}
 					prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+					server.Metrics.RecordLogsReceived(logLinesByServer[identifier][len(logLinesByServer[identifier])-1].CollectedAt)
 					logLinesByServer[identifier] = processLogStream(ctx, server, logLinesByServer[identifier], t, opts, prefixedLogger, logTestSucceeded, logTestFunc)
 				}
+
+				// Lines kept for the next run (too fresh to be analyzed), as well as those
+				// received but not yet picked up, indicate whether analysis keeps up
+				queueDepth := len(parsedLogStream)
+				for _, logLines := range logLinesByServer {
+					queueDepth += len(logLines)
+				}
+				stream.RecordQueueDepth(queueDepth)
 			case in, ok := <-parsedLogStream:
 				var err error
 				if !ok {
@@ -284,6 +313,7 @@ func processLogStream(ctx context.Context, server *state.Server, logLines []stat
 		// around temporary failues, and otherwise we would keep processing
 		// more and more lines in error scenarios
 		logger.PrintError("Log sending error (discarding lines): %s", err)
+		stream.RecordDroppedLines(len(logFile.LogLines))
 		return tooFreshLogLines
 	}
 	if !server.Grant.Load().ValidConfig {
@@ -293,6 +323,7 @@ func processLogStream(ctx context.Context, server *state.Server, logLines []stat
 	err = postprocessAndSendLogs(ctx, server, opts, logger, transientLogState)
 	if err != nil {
 		logger.PrintError("Log sending error (discarding lines): %s", err)
+		stream.RecordDroppedLines(len(logFile.LogLines))
 		return tooFreshLogLines
 	}
 
@@ -333,6 +364,10 @@ func postprocessAndSendLogs(ctx context.Context, server *state.Server, opts stat
 		// Do nothing if filter_query_sample = none (we just take the query samples as they are generated)
 	}
 
+	if len(server.Config.FilterLogRulesParsed) > 0 {
+		logs.ReplaceCustomSecretsInQuerySamples(transientLogState.QuerySamples, server.Config.FilterLogRulesParsed)
+	}
+
 	// Export query samples as traces, if OpenTelemetry endpoint is configured
 	if server.Config.OTelTracingProvider != nil && len(transientLogState.QuerySamples) != 0 {
 		querysample.ExportQuerySamplesAsTraceSpans(ctx, server, logger, server.Grant.Load().Config.ServerUrl, transientLogState.QuerySamples)
@@ -342,6 +377,10 @@ func postprocessAndSendLogs(ctx context.Context, server *state.Server, opts stat
 		// The actual filtering (aka masking of secrets) is done later in
 		// EncryptAndUploadLogfiles, based on this setting
 		transientLogState.LogFiles[idx].FilterLogSecret = state.ParseFilterLogSecret(server.Config.FilterLogSecret)
+		if len(server.Config.FilterLogRulesParsed) > 0 {
+			logs.MarkCustomSecrets(transientLogState.LogFiles[idx].LogLines, server.Config.FilterLogRulesParsed)
+			transientLogState.LogFiles[idx].FilterLogSecret = append(transientLogState.LogFiles[idx].FilterLogSecret, state.CustomLogSecret)
+		}
 	}
 
 	logsExist := false
@@ -350,12 +389,17 @@ func postprocessAndSendLogs(ctx context.Context, server *state.Server, opts stat
 		if len(logFile.LogLines) > 0 {
 			logsExist = true
 			logFile.UpdateByteSize()
+			server.Metrics.AddLogLinesProcessed(len(logFile.LogLines))
 		}
 		if len(logFile.FilterLogSecret) > 0 {
 			logs.ReplaceSecrets(logFile.LogLines, logFile.FilterLogSecret)
 		}
 	}
 
+	if server.LogArchive != nil {
+		output.ArchiveLogs(server, transientLogState, logger)
+	}
+
 	if opts.DebugLogs {
 		logger.PrintInfo("Would have sent log state:\n")
 		for _, logFile := range transientLogState.LogFiles {
@@ -424,6 +468,8 @@ func TestLogsForAllServers(ctx context.Context, servers []*state.Server, opts st
 			} else {
 				success = false
 			}
+		} else if server.Config.LogKafkaTopic != "" {
+			success = testKafkaLogStream(sctx, &wg, server, opts, prefixedLogger)
 		} else if server.Config.SupportsLogDownload() {
 			success = testLogDownload(sctx, &wg, server, opts, prefixedLogger)
 		} else if server.Config.AzureEventhubNamespace != "" && server.Config.AzureEventhubName != "" {
@@ -576,6 +622,36 @@ func testGoogleCloudsqlLogStream(ctx context.Context, wg *sync.WaitGroup, server
 	return true
 }
 
+func testKafkaLogStream(ctx context.Context, wg *sync.WaitGroup, server *state.Server, opts state.CollectionOpts, logger *util.Logger) bool {
+	logger.PrintInfo("Testing log collection (Kafka)...")
+
+	logTestSucceeded := make(chan bool, 1)
+	parsedLogStream := setupLogStreamer(ctx, wg, opts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestCollectorIdentify)
+
+	err := kafka.SetupLogConsumer(ctx, wg, opts, logger, []*state.Server{server}, parsedLogStream)
+	if err != nil {
+		logger.PrintError("ERROR - Could not get logs through Kafka: %s", err)
+		return false
+	}
+
+	EmitTestLogMsg(ctx, server, opts, logger)
+
+	timeout := logTestTimeout(server.Config)
+	select {
+	case <-ctx.Done():
+		return false
+	case <-logTestSucceeded:
+		break
+	case <-time.After(timeout):
+		logger.PrintError("ERROR - Kafka log consumer timed out after %d seconds - did not find expected log event in stream", int(timeout.Seconds()))
+		logger.PrintInfo("HINT - Check that Postgres log events are published to the configured Kafka topic, in the format set by db_log_kafka_format")
+		return false
+	}
+
+	logger.PrintInfo("  Log test successful")
+	return true
+}
+
 func testOtelLog(ctx context.Context, wg *sync.WaitGroup, server *state.Server, opts state.CollectionOpts, logger *util.Logger) bool {
 	logger.PrintInfo("Testing log collection (OpenTelemetry Log receiving)...")
 
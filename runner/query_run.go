@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -20,7 +21,22 @@ func SetupQueryRunnerForAllServers(ctx context.Context, servers []*state.Server,
 	}
 	for idx := range servers {
 		go func(server *state.Server) {
-			logger = logger.WithPrefixAndRememberErrors(server.Config.SectionName)
+			logger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
+
+			// Maintenance commands (e.g. index builds) can run for a long time, so they
+			// are run by a separate worker, to not hold up EXPLAIN query runs
+			go func() {
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					default:
+						run(ctx, server, collectionOpts, logger, true)
+						time.Sleep(1 * time.Second)
+					}
+				}
+			}()
+
 			cleanupInterval := time.NewTicker(5 * time.Minute)
 			for {
 				select {
@@ -29,7 +45,7 @@ func SetupQueryRunnerForAllServers(ctx context.Context, servers []*state.Server,
 				case <-cleanupInterval.C:
 					cleanup(server)
 				default:
-					run(ctx, server, collectionOpts, logger)
+					run(ctx, server, collectionOpts, logger, false)
 					time.Sleep(1 * time.Second)
 				}
 			}
@@ -37,13 +53,33 @@ func SetupQueryRunnerForAllServers(ctx context.Context, servers []*state.Server,
 	}
 }
 
-func run(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger) {
+func isMaintenanceQueryRun(queryType pganalyze_collector.QueryRunType) bool {
+	switch queryType {
+	case pganalyze_collector.QueryRunType_ANALYZE, pganalyze_collector.QueryRunType_REINDEX,
+		pganalyze_collector.QueryRunType_CREATE_INDEX, pganalyze_collector.QueryRunType_DROP_INDEX:
+		return true
+	}
+	return false
+}
+
+// Runs pending query runs, either only maintenance commands, or only other query runs
+func run(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, maintenance bool) {
+	// Both workers run concurrently, so only access the query runs map with the lock held
+	server.QueryRunsMutex.Lock()
+	pending := make(map[int64]*state.QueryRun)
 	for id, query := range server.QueryRuns {
-		if !query.FinishedAt.IsZero() {
-			continue
+		if query.FinishedAt.IsZero() && isMaintenanceQueryRun(query.Type) == maintenance {
+			pending[id] = query
 		}
+	}
+	server.QueryRunsMutex.Unlock()
 
+	for id, query := range pending {
 		server.QueryRunsMutex.Lock()
+		if server.QueryRuns[id].CancelRequested {
+			server.QueryRunsMutex.Unlock()
+			continue
+		}
 		server.QueryRuns[id].StartedAt = time.Now()
 		server.QueryRunsMutex.Unlock()
 		logger.PrintVerbose("Query run %d starting: %s", query.Id, query.QueryText)
@@ -53,32 +89,45 @@ func run(ctx context.Context, server *state.Server, collectionOpts state.Collect
 			server.QueryRunsMutex.Lock()
 			server.QueryRuns[id].FinishedAt = time.Now()
 			server.QueryRuns[id].Error = err.Error()
+			cancelled := server.QueryRuns[id].CancelRequested
+			server.QueryRuns[id].Cancelled = cancelled
+			finished := *server.QueryRuns[id]
 			server.QueryRunsMutex.Unlock()
+			if cancelled {
+				logger.PrintVerbose("Query run %d cancelled: %s", query.Id, err)
+				output.SubmitQueryRunSnapshot(ctx, server, collectionOpts, logger, finished)
+			}
 			continue
 		}
 
 		server.QueryRunsMutex.Lock()
 		server.QueryRuns[id].FinishedAt = time.Now()
 		server.QueryRuns[id].Result = result
+		finished := *server.QueryRuns[id]
 		server.QueryRunsMutex.Unlock()
 
 		// Activity snapshots will eventually send the query run result, but to reduce latency
 		// we also send a query run snapshot immediately after the query has finished.
-		output.SubmitQueryRunSnapshot(ctx, server, collectionOpts, logger, *server.QueryRuns[id])
+		output.SubmitQueryRunSnapshot(ctx, server, collectionOpts, logger, finished)
 	}
 }
 
 func runQueryOnDatabase(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, id int64, query *state.QueryRun) (string, error) {
-	if query.Type != pganalyze_collector.QueryRunType_EXPLAIN {
+	switch {
+	case query.Type == pganalyze_collector.QueryRunType_EXPLAIN:
+		// Handled below
+	case isMaintenanceQueryRun(query.Type):
+		return runMaintenanceOnDatabase(ctx, server, collectionOpts, logger, id, query)
+	default:
 		logger.PrintVerbose("Unhandled query run type %d for %d", query.Type, query.Id)
 		return "", errors.New("Unhandled query run type")
 	}
 
-	db, err := postgres.EstablishConnection(ctx, server, logger, collectionOpts, query.DatabaseName)
+	db, err := postgres.AcquireConnection(ctx, server, logger, collectionOpts, query.DatabaseName)
 	if err != nil {
 		return "", err
 	}
-	defer db.Close()
+	defer postgres.ReleaseConnection(server, logger, db)
 
 	h, err := postgres.NewCollection(ctx, logger, server, collectionOpts, db)
 	if err != nil {
@@ -100,22 +149,318 @@ func runQueryOnDatabase(ctx context.Context, server *state.Server, collectionOpt
 	server.QueryRuns[id].BackendPid = pid
 	server.QueryRunsMutex.Unlock()
 
-	for name, value := range query.PostgresSettings {
-		_, err = db.ExecContext(ctx, postgres.QueryMarkerSQL+fmt.Sprintf("SET %s = %s", pq.QuoteIdentifier(name), pq.QuoteLiteral(value)))
+	err = setQueryRunSettings(ctx, db, query.PostgresSettings)
+	if err != nil {
+		return "", err
+	}
+
+	err = postgres.SetStatementTimeout(ctx, db, 60*1000)
+	if err != nil {
+		return "", err
+	}
+
+	if queryRunCancelRequested(server, id) {
+		return "", errQueryRunCancelled
+	}
+
+	// We don't include QueryMarkerSQL so query runs are reported separately in pganalyze
+	marker := fmt.Sprintf("/* pganalyze:no-alert,pganalyze-query-run:%d */ ", query.Id)
+
+	return postgres.RunExplainAnalyzeForQueryRun(ctx, db, query.QueryText, query.QueryParameters, query.QueryParameterTypes, marker)
+}
+
+// Lock timeout for maintenance commands, unless a different (non-zero) value is
+// requested through the query run settings
+const maintenanceLockTimeout = "5s"
+
+const queryRunProgressInterval = 5 * time.Second
+
+func runMaintenanceOnDatabase(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, id int64, query *state.QueryRun) (string, error) {
+	cmd, err := postgres.ValidateMaintenanceCommand(query.Type, query.QueryText)
+	if err != nil {
+		return "", err
+	}
+
+	// This intentionally does not use the connection pool, so that the recorded
+	// backend PID (used for cancellation) can't refer to an unrelated connection
+	// once the query run has finished
+	db, err := postgres.EstablishConnection(ctx, server, logger, collectionOpts, query.DatabaseName)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	isReplica, err := postgres.GetIsReplica(ctx, logger, db)
+	if err != nil {
+		return "", err
+	}
+	if isReplica {
+		return "", errors.New("Refusing to run maintenance command on a replica")
+	}
+
+	h, err := postgres.NewCollection(ctx, logger, server, collectionOpts, db)
+	if err != nil {
+		return "", err
+	}
+
+	if h.HelperExists("maintenance_role", nil) {
+		logger.PrintVerbose("Found pganalyze.maintenance_role helper function in database \"%s\"", query.DatabaseName)
+	} else {
+		return "", fmt.Errorf("Required helper function pganalyze.maintenance_role is not set up")
+	}
+
+	isAnalyze := query.Type == pganalyze_collector.QueryRunType_ANALYZE
+	if isAnalyze && !h.HelperExists("analyze_table", []string{"regclass", "text[]"}) {
+		return "", fmt.Errorf("Required helper function pganalyze.analyze_table is not set up")
+	}
+
+	// Session settings (role, timeouts) must apply to the maintenance command
+	// itself, so the whole sequence runs on a single connection, instead of
+	// whichever connection *sql.DB picks (or re-establishes) for each statement
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	pid := 0
+	err = conn.QueryRowContext(ctx, postgres.QueryMarkerSQL+"SELECT pg_backend_pid()").Scan(&pid)
+	if err != nil {
+		return "", err
+	}
+	server.QueryRunsMutex.Lock()
+	server.QueryRuns[id].BackendPid = pid
+	server.QueryRunsMutex.Unlock()
+
+	// ANALYZE runs through the pganalyze.analyze_table SECURITY DEFINER helper.
+	// Index commands using CONCURRENTLY can't run inside a function, and require
+	// the monitoring user to have been explicitly granted the maintenance role.
+	if !isAnalyze {
+		var role string
+		err = conn.QueryRowContext(ctx, postgres.QueryMarkerSQL+"SELECT pganalyze.maintenance_role()").Scan(&role)
+		if err != nil {
+			return "", err
+		}
+		var isMember bool
+		err = conn.QueryRowContext(ctx, postgres.QueryMarkerSQL+"SELECT pg_catalog.pg_has_role($1, 'MEMBER')", role).Scan(&isMember)
+		if err != nil {
+			return "", err
+		}
+		if !isMember {
+			return "", fmt.Errorf("Monitoring user is not a member of maintenance role \"%s\", which is required for index commands (see the commented out GRANT in the generated maintenance helper SQL)", role)
+		}
+		_, err = conn.ExecContext(ctx, postgres.QueryMarkerSQL+"SET ROLE "+pq.QuoteIdentifier(role))
 		if err != nil {
 			return "", err
 		}
 	}
 
-	err = postgres.SetStatementTimeout(ctx, db, 60*1000)
+	err = setQueryRunSettings(ctx, conn, query.PostgresSettings)
 	if err != nil {
 		return "", err
 	}
 
+	// Index builds can take a long time, but must not hold up other queries by
+	// waiting on locks for long
+	if _, ok := query.PostgresSettings["lock_timeout"]; !ok {
+		_, err = conn.ExecContext(ctx, postgres.QueryMarkerSQL+"SET lock_timeout = "+pq.QuoteLiteral(maintenanceLockTimeout))
+		if err != nil {
+			return "", err
+		}
+	}
+	var lockTimeout string
+	err = conn.QueryRowContext(ctx, postgres.QueryMarkerSQL+"SELECT pg_catalog.current_setting('lock_timeout')").Scan(&lockTimeout)
+	if err != nil {
+		return "", err
+	}
+	if lockTimeout == "0" {
+		return "", errors.New("Refusing to run maintenance command without lock_timeout")
+	}
+
+	_, err = conn.ExecContext(ctx, postgres.QueryMarkerSQL+"SET statement_timeout = 0")
+	if err != nil {
+		return "", err
+	}
+
+	if query.Type == pganalyze_collector.QueryRunType_CREATE_INDEX || query.Type == pganalyze_collector.QueryRunType_REINDEX {
+		progressDone := make(chan struct{})
+		defer close(progressDone)
+		go trackQueryRunProgress(ctx, server, collectionOpts, logger, id, pid, progressDone)
+	}
+
+	if queryRunCancelRequested(server, id) {
+		return "", errQueryRunCancelled
+	}
+
 	// We don't include QueryMarkerSQL so query runs are reported separately in pganalyze
 	marker := fmt.Sprintf("/* pganalyze:no-alert,pganalyze-query-run:%d */ ", query.Id)
 
-	return postgres.RunExplainAnalyzeForQueryRun(ctx, db, query.QueryText, query.QueryParameters, query.QueryParameterTypes, marker)
+	if isAnalyze {
+		for _, table := range cmd.AnalyzeTables {
+			_, err = conn.ExecContext(ctx, marker+"SELECT pganalyze.analyze_table($1, $2)", table.Name, pq.Array(table.Columns))
+			if err != nil {
+				return "", err
+			}
+		}
+		return "", nil
+	}
+
+	_, err = conn.ExecContext(ctx, marker+query.QueryText)
+	if err != nil && cmd.IndexName != "" {
+		// A failed CREATE INDEX CONCURRENTLY leaves behind an invalid index, that
+		// still adds overhead to writes. Since the context may have been canceled,
+		// this uses a separate timeout.
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		dropped, dropErr := postgres.DropInvalidIndex(cleanupCtx, conn, cmd)
+		if dropErr != nil {
+			return "", fmt.Errorf("%s (failed to drop invalid index: %s)", err, dropErr)
+		} else if dropped != "" {
+			return "", fmt.Errorf("%s (dropped invalid index %s)", err, dropped)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
+
+// Periodically records the progress of a CREATE INDEX or REINDEX query run
+// running in the given backend, until done is closed
+func trackQueryRunProgress(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, id int64, pid int, done <-chan struct{}) {
+	ticker := time.NewTicker(queryRunProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		db, err := postgres.AcquireConnection(ctx, server, logger, collectionOpts, "")
+		if err != nil {
+			logger.PrintVerbose("Could not connect to get progress for query run %d: %s", id, err)
+			continue
+		}
+		progress, err := postgres.GetCreateIndexProgress(ctx, db, pid)
+		postgres.ReleaseConnection(server, logger, db)
+		if err != nil {
+			logger.PrintVerbose("Could not get progress for query run %d: %s", id, err)
+			continue
+		}
+		if progress != nil {
+			server.QueryRunsMutex.Lock()
+			server.QueryRuns[id].Progress = progress
+			server.QueryRunsMutex.Unlock()
+		}
+	}
+}
+
+// Implemented by both *sql.DB and *sql.Conn
+type sqlExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func setQueryRunSettings(ctx context.Context, db sqlExecer, settings map[string]string) error {
+	for name, value := range settings {
+		_, err := db.ExecContext(ctx, postgres.QueryMarkerSQL+fmt.Sprintf("SET %s = %s", pq.QuoteIdentifier(name), pq.QuoteLiteral(value)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var errQueryRunCancelled = errors.New("Query run cancelled before it started executing")
+
+// Time to wait for a query run to stop after cancelling it, before terminating
+// its backend instead
+const queryRunCancelGracePeriod = 10 * time.Second
+
+func queryRunCancelRequested(server *state.Server, id int64) bool {
+	server.QueryRunsMutex.Lock()
+	defer server.QueryRunsMutex.Unlock()
+	return server.QueryRuns[id].CancelRequested
+}
+
+// Cancels a query run on request of the pganalyze server
+//
+// Query runs that haven't started yet are marked as cancelled right away. For
+// running query runs the backend is cancelled with pg_cancel_backend once the
+// query is executing, and terminated if it hasn't stopped after the grace period.
+func cancelQueryRun(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, id int64) {
+	server.QueryRunsMutex.Lock()
+	query, exists := server.QueryRuns[id]
+	if !exists || !query.FinishedAt.IsZero() {
+		server.QueryRunsMutex.Unlock()
+		logger.PrintVerbose("Query run %d cancellation requested, but query run is not in progress", id)
+		return
+	}
+	query.CancelRequested = true
+	if query.StartedAt.IsZero() {
+		query.FinishedAt = time.Now()
+		query.Error = "Query run cancelled before it started"
+		query.Cancelled = true
+		cancelled := *query
+		server.QueryRunsMutex.Unlock()
+		logger.PrintVerbose("Query run %d cancelled before it started", id)
+		output.SubmitQueryRunSnapshot(ctx, server, collectionOpts, logger, cancelled)
+		return
+	}
+	server.QueryRunsMutex.Unlock()
+
+	cancelSent := false
+	deadline := time.Now().Add(queryRunCancelGracePeriod)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		server.QueryRunsMutex.Lock()
+		finished := !query.FinishedAt.IsZero()
+		pid := query.BackendPid
+		server.QueryRunsMutex.Unlock()
+		if finished {
+			return
+		}
+
+		// The backend PID is only known once the query run connected, and the query
+		// may not be executing yet (in which case it won't be started at all)
+		terminate := time.Now().After(deadline)
+		if pid != 0 && (!cancelSent || terminate) {
+			signalled, err := signalQueryRun(ctx, server, collectionOpts, logger, id, pid, terminate)
+			if err != nil {
+				logger.PrintWarning("Could not cancel query run %d: %s", id, err)
+			} else if signalled && terminate {
+				logger.PrintInfo("Terminated backend %d for query run %d, since it did not stop after being cancelled", pid, id)
+				return
+			} else if signalled {
+				logger.PrintVerbose("Cancelled backend %d for query run %d", pid, id)
+				cancelSent = true
+			}
+		}
+		if time.Now().After(deadline.Add(queryRunCancelGracePeriod)) {
+			logger.PrintWarning("Query run %d did not stop after cancellation", id)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func signalQueryRun(ctx context.Context, server *state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, id int64, pid int, terminate bool) (bool, error) {
+	db, err := postgres.AcquireConnection(ctx, server, logger, collectionOpts, "")
+	if err != nil {
+		return false, err
+	}
+	defer postgres.ReleaseConnection(server, logger, db)
+
+	return postgres.SignalQueryRunBackend(ctx, db, pid, id, terminate)
 }
 
 // Removes old query runs that have finished
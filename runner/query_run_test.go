@@ -0,0 +1,44 @@
+package runner
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestCancelQueryRun(t *testing.T) {
+	ctx := context.Background()
+	logger := &util.Logger{Destination: log.New(io.Discard, "", 0)}
+	server := state.MakeServer(config.ServerConfig{}, false)
+	finishedAt := time.Now().Add(-time.Minute)
+	server.QueryRuns[1] = &state.QueryRun{Id: 1}
+	server.QueryRuns[2] = &state.QueryRun{Id: 2, StartedAt: finishedAt, FinishedAt: finishedAt, Result: "done"}
+
+	cancelQueryRun(ctx, server, state.CollectionOpts{}, logger, 1)
+	if q := server.QueryRuns[1]; !q.Cancelled || !q.CancelRequested || q.FinishedAt.IsZero() {
+		t.Errorf("query run not started yet: want cancelled and finished; got %+v", q)
+	}
+
+	// The query runner must not pick up the cancelled query run
+	run(ctx, server, state.CollectionOpts{}, logger, false)
+	if q := server.QueryRuns[1]; !q.StartedAt.IsZero() {
+		t.Errorf("cancelled query run: want not started; got started at %s", q.StartedAt)
+	}
+
+	cancelQueryRun(ctx, server, state.CollectionOpts{}, logger, 2)
+	if q := server.QueryRuns[2]; q.Cancelled || q.CancelRequested || q.Result != "done" {
+		t.Errorf("finished query run: want unchanged; got %+v", q)
+	}
+
+	// Unknown query runs are ignored
+	cancelQueryRun(ctx, server, state.CollectionOpts{}, logger, 3)
+	if len(server.QueryRuns) != 2 {
+		t.Errorf("want 2 query runs; got %d", len(server.QueryRuns))
+	}
+}
@@ -21,9 +21,10 @@ import (
 	"github.com/pganalyze/collector/selftest"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/secrets"
 )
 
-func Run(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, logger *util.Logger, configFilename string) (keepRunning bool, testRunSuccess chan bool, writeStateFile func(), shutdown func()) {
+func Run(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, logger *util.Logger, configFilename string, configChanged chan<- struct{}) (keepRunning bool, testRunSuccess chan bool, writeStateFile func(), shutdown func()) {
 	var servers []*state.Server
 
 	keepRunning = false
@@ -45,9 +46,17 @@ func Run(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, log
 			testRunSuccess = make(chan bool, 1)
 			testRunSuccess <- false
 		}
+		if keepRunning && configChanged != nil {
+			// Pick up the configuration once it's fixed
+			SetupConfigWatcher(ctx, configFilename, config.Config{}, logger, configChanged)
+		}
 		return
 	}
 
+	if configChanged != nil {
+		SetupConfigWatcher(ctx, configFilename, conf, logger, configChanged)
+	}
+
 	needsIAMCloudSQL := false
 	needsIAMAlloyDB := false
 	for idx, cfg := range conf.Servers {
@@ -194,6 +203,34 @@ func Run(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, log
 		return
 	}
 
+	if opts.GenerateMaintenanceHelperSql != "" {
+		wg.Add(1)
+		testRunSuccess = make(chan bool)
+		go func() {
+			var matchingServer *state.Server
+			for _, server := range servers {
+				if opts.GenerateMaintenanceHelperSql == server.Config.SectionName {
+					matchingServer = server
+				}
+			}
+			if matchingServer == nil {
+				fmt.Fprintf(os.Stderr, "ERROR - Specified configuration section name '%s' not known\n", opts.GenerateMaintenanceHelperSql)
+				testRunSuccess <- false
+			} else {
+				output, err := GenerateMaintenanceHelperSql(ctx, matchingServer, opts, logger.WithPrefix(matchingServer.Config.SectionName))
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "ERROR - %s\n", err)
+					testRunSuccess <- false
+				} else {
+					fmt.Print(output)
+					testRunSuccess <- true
+				}
+			}
+			wg.Done()
+		}()
+		return
+	}
+
 	state.ReadStateFile(servers, opts, logger)
 
 	writeStateFile = func() {
@@ -307,6 +344,9 @@ func Run(ctx context.Context, wg *sync.WaitGroup, opts state.CollectionOpts, log
 	SetupWebsocketForAllServers(ctx, servers, opts, logger)
 	output.SetupSnapshotUploadForAllServers(ctx, servers, opts, logger)
 	SetupQueryRunnerForAllServers(ctx, servers, opts, logger)
+	postgres.SetupConnectionPoolForAllServers(ctx, servers)
+	postgres.SetupSecretProviderForAllServers(ctx, servers, logger)
+	output.SetupStatusEndpointsForAllServers(ctx, servers, opts, logger)
 
 	keepRunning = true
 	return
@@ -326,6 +366,10 @@ func checkOneInitialCollectionStatus(ctx context.Context, server *state.Server,
 	conn, err := postgres.EstablishConnection(ctx, server, logger, opts, "")
 	if err != nil {
 		server.SelfTest.MarkCollectionAspectError(state.CollectionAspectMonitoringDbConnection, "%s", err.Error())
+		var providerErr *secrets.ProviderError
+		if errors.As(err, &providerErr) {
+			server.SelfTest.HintCollectionAspect(state.CollectionAspectMonitoringDbConnection, "Check the %s secret provider settings, and that the collector can reach it", providerErr.Provider)
+		}
 		return errors.Wrap(err, "failed to connect to database")
 	}
 	defer conn.Close()
@@ -369,9 +413,10 @@ func checkOneInitialCollectionStatus(ctx context.Context, server *state.Server,
 	parser := server.GetLogParser()
 	if parser == nil {
 		logger.PrintWarning("Could not initialize log parser for server")
-	} else if server.Config.LogOtelServer == "" {
+	} else if server.Config.LogOtelServer == "" && (server.Config.LogKafkaTopic == "" || server.Config.LogKafkaFormat == "stderr") {
 		// Validate that log_line_prefix contains necessary parts, except if we're getting
-		// jsonlog data over OpenTelemetry (which always has all we need)
+		// jsonlog data over OpenTelemetry, or csvlog/jsonlog data from Kafka (which
+		// always has all we need)
 		prefixErr := parser.ValidatePrefix()
 		if prefixErr != nil {
 			logger.PrintWarning("Checking log_line_prefix: %s", prefixErr)
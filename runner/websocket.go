@@ -30,11 +30,11 @@ func SetupWebsocketForAllServers(ctx context.Context, servers []*state.Server, o
 		)
 
 		// Server messages are read in processServerMessages, snapshots are sent via output.SetupSnapshotUploadForAllServers
-		go processServerMessages(ctx, server, prefixedLogger)
+		go processServerMessages(ctx, server, opts, prefixedLogger)
 	}
 }
 
-func processServerMessages(ctx context.Context, server *state.Server, logger *util.Logger) {
+func processServerMessages(ctx context.Context, server *state.Server, opts state.CollectionOpts, logger *util.Logger) {
 	initialConfig := true
 	for {
 		select {
@@ -87,6 +87,10 @@ func processServerMessages(ctx context.Context, server *state.Server, logger *ut
 					}
 				}
 				server.QueryRunsMutex.Unlock()
+			} else if message.GetCancelQueryRun() != nil {
+				id := message.GetCancelQueryRun().Id
+				logger.PrintVerbose("Query run %d cancellation received", id)
+				go cancelQueryRun(ctx, server, opts, logger, id)
 			}
 		}
 	}
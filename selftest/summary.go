@@ -222,6 +222,11 @@ func printServerTestSummary(s *state.Server, verbosity SummaryVerbosity) {
 
 	fmt.Fprintf(os.Stderr, "\t  View in pganalyze:\t%s\n", URLPrinter.Sprint(s.Grant.Load().Config.ServerUrl))
 
+	if s.SnapshotSpool != nil {
+		spoolIcon, spoolMsg := getSnapshotSpoolStatus(s.SnapshotSpool)
+		fmt.Fprintf(os.Stderr, "\t%s Snapshot spool:\t%s\n", spoolIcon, spoolMsg)
+	}
+
 	fmt.Fprintln(os.Stderr)
 	if verbosity == VerbosityTerse {
 		allOk := checkAllAspectsOk(status)
@@ -310,6 +315,17 @@ func printServerTestSummary(s *state.Server, verbosity SummaryVerbosity) {
 	printAspectStatus(status, state.CollectionAspectSystemStats, "System")
 }
 
+func getSnapshotSpoolStatus(spool *state.SnapshotSpool) (icon string, msg string) {
+	count, size, err := spool.Stats()
+	if err != nil {
+		return RedX, fmt.Sprintf("could not read %s: %s", spool.Dir(), err)
+	}
+	if count == 0 {
+		return GreenCheck, "no snapshots pending upload"
+	}
+	return YellowBang, fmt.Sprintf("%d snapshot(s) pending upload (%.1f MB in %s)", count, float64(size)/1024.0/1024.0, spool.Dir())
+}
+
 func checkAllAspectsOk(status *state.SelfTestResult) bool {
 	for _, aspect := range state.CollectionAspects {
 		status := status.GetCollectionAspectStatus(aspect)
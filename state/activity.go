@@ -10,6 +10,9 @@ type TransientActivityState struct {
 	Backends []PostgresBackend
 
 	Vacuums []PostgresVacuumProgress
+
+	Locks     []PostgresLock
+	LockWaits []PostgresLockWait
 }
 
 type PersistedActivityState struct {
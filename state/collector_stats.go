@@ -11,6 +11,13 @@ type CollectorStats struct {
 	ActiveGoroutines int32
 
 	CgoCalls int64
+
+	// Backpressure of stream-based log analysis (across all servers)
+	LogStreamQueueDepth     int64   // Log lines waiting to be analyzed
+	LogStreamAnalysisRuns   int64   // Number of times log lines were analyzed
+	LogStreamAnalysisTimeMs float64 // Time spent analyzing log lines, in milliseconds
+	LogStreamAnalyzedLines  int64   // Number of log lines that were analyzed
+	LogStreamDroppedLines   int64   // Number of log lines that were dropped without being sent
 }
 
 type DiffedCollectorStats CollectorStats
@@ -24,5 +31,10 @@ func (curr CollectorStats) DiffSince(prev CollectorStats) DiffedCollectorStats {
 		MemoryRssBytes:           curr.MemoryRssBytes,
 		ActiveGoroutines:         curr.ActiveGoroutines,
 		CgoCalls:                 curr.CgoCalls - prev.CgoCalls,
+		LogStreamQueueDepth:      curr.LogStreamQueueDepth,
+		LogStreamAnalysisRuns:    curr.LogStreamAnalysisRuns - prev.LogStreamAnalysisRuns,
+		LogStreamAnalysisTimeMs:  curr.LogStreamAnalysisTimeMs - prev.LogStreamAnalysisTimeMs,
+		LogStreamAnalyzedLines:   curr.LogStreamAnalyzedLines - prev.LogStreamAnalyzedLines,
+		LogStreamDroppedLines:    curr.LogStreamDroppedLines - prev.LogStreamDroppedLines,
 	}
 }
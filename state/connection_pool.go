@@ -0,0 +1,322 @@
+package state
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// Connections are closed after this time even if they are in regular use, so
+// that DNS changes (e.g. after a failover) and credential rotations get picked up
+const connectionPoolMaxLifetime = 30 * time.Minute
+
+// PooledConnection - A database connection that is kept open for reuse
+type PooledConnection struct {
+	DB           *sql.DB
+	DatabaseName string
+
+	// Postgres backend PID of the connection, used to detect when database/sql
+	// silently reconnected, losing session settings like statement_timeout
+	BackendPid int32
+
+	// Whether the server was in recovery when the connection was established,
+	// used to detect failovers where the connection itself stays intact
+	InRecovery bool
+
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+
+	// Connections opened while the pool is full are closed again after use
+	unpooled bool
+}
+
+// ConnectionPool - Database connections for a server, kept open between
+// collector runs and keyed by database name
+//
+// Each connection is used by one caller at a time, since callers change
+// session settings (e.g. statement_timeout) that must not leak to others.
+// Connections in use count towards the limit, but are never closed by the pool.
+type ConnectionPool struct {
+	mutex sync.Mutex
+	idle  map[string][]*PooledConnection
+	inUse map[*sql.DB]*PooledConnection
+
+	maxOpen     int
+	maxIdle     int
+	idleTimeout time.Duration
+}
+
+// NewConnectionPool - Creates a connection pool that keeps at most maxOpen
+// connections open, unless it is used by more callers at the same time
+//
+// Only half of maxOpen connections are kept while idle, to leave room for
+// connections that are opened outside of the pool (e.g. for full snapshots).
+func NewConnectionPool(maxOpen int, idleTimeout time.Duration) *ConnectionPool {
+	maxIdle := maxOpen / 2
+	if maxIdle < 1 {
+		maxIdle = 1
+	}
+	return &ConnectionPool{
+		idle:        make(map[string][]*PooledConnection),
+		inUse:       make(map[*sql.DB]*PooledConnection),
+		maxOpen:     maxOpen,
+		maxIdle:     maxIdle,
+		idleTimeout: idleTimeout,
+	}
+}
+
+// Get - Takes the most recently used idle connection for the database out of the
+// pool, or returns nil if there is none
+//
+// The caller is responsible for checking the connection is still usable, and
+// must return it with Put (or Discard) after use.
+func (p *ConnectionPool) Get(databaseName string, now time.Time) *PooledConnection {
+	if p == nil {
+		return nil
+	}
+	p.mutex.Lock()
+	var conn *PooledConnection
+	var expired []*PooledConnection
+	for conns := p.idle[databaseName]; len(conns) > 0; conns = p.idle[databaseName] {
+		c := conns[len(conns)-1]
+		p.removeIdle(c)
+		if p.expired(c, now) {
+			expired = append(expired, c)
+			continue
+		}
+		conn = c
+		p.inUse[conn.DB] = conn
+		break
+	}
+	p.mutex.Unlock()
+
+	closeConnections(expired)
+	return conn
+}
+
+// Add - Registers a newly established connection as being in use
+//
+// If the pool is full, idle connections (to any database) are closed to make room,
+// and if that is not sufficient the connection is closed once returned.
+func (p *ConnectionPool) Add(db *sql.DB, databaseName string, backendPid int32, inRecovery bool, now time.Time) {
+	if p == nil {
+		return
+	}
+	p.mutex.Lock()
+	conn := &PooledConnection{DB: db, DatabaseName: databaseName, BackendPid: backendPid, InRecovery: inRecovery, CreatedAt: now, LastUsedAt: now}
+	p.inUse[db] = conn
+	var evicted []*PooledConnection
+	for p.openCount() > p.maxOpen {
+		oldest := p.leastRecentlyUsedIdle()
+		if oldest == nil {
+			conn.unpooled = true
+			break
+		}
+		p.removeIdle(oldest)
+		evicted = append(evicted, oldest)
+	}
+	p.mutex.Unlock()
+
+	closeConnections(evicted)
+}
+
+// Put - Returns a connection that is no longer in use to the pool
+//
+// The caller must have reset any session state before returning the connection.
+// Connections that are not reusable (e.g. because resetting them failed) should
+// be passed to Discard instead.
+func (p *ConnectionPool) Put(db *sql.DB, now time.Time) {
+	if p == nil {
+		db.Close()
+		return
+	}
+	p.mutex.Lock()
+	conn, ok := p.inUse[db]
+	if !ok || conn.unpooled || now.Sub(conn.CreatedAt) > connectionPoolMaxLifetime {
+		delete(p.inUse, db)
+		p.mutex.Unlock()
+		db.Close()
+		return
+	}
+	delete(p.inUse, db)
+	conn.LastUsedAt = now
+	p.idle[conn.DatabaseName] = append(p.idle[conn.DatabaseName], conn)
+
+	var evicted []*PooledConnection
+	for p.idleCount() > p.maxIdle {
+		oldest := p.leastRecentlyUsedIdle()
+		p.removeIdle(oldest)
+		evicted = append(evicted, oldest)
+	}
+	p.mutex.Unlock()
+
+	closeConnections(evicted)
+}
+
+// Discard - Closes a connection that was taken from the pool, without returning it
+func (p *ConnectionPool) Discard(db *sql.DB) {
+	if p != nil {
+		p.mutex.Lock()
+		delete(p.inUse, db)
+		p.mutex.Unlock()
+	}
+	db.Close()
+}
+
+// CloseIdle - Closes idle connections that have not been used within the idle
+// timeout, or that have exceeded their maximum lifetime
+func (p *ConnectionPool) CloseIdle(now time.Time) {
+	if p == nil {
+		return
+	}
+	p.mutex.Lock()
+	var expired []*PooledConnection
+	for _, conns := range p.idle {
+		for _, conn := range conns {
+			if p.expired(conn, now) {
+				expired = append(expired, conn)
+			}
+		}
+	}
+	for _, conn := range expired {
+		p.removeIdle(conn)
+	}
+	p.mutex.Unlock()
+
+	closeConnections(expired)
+}
+
+// CloseLeastRecentlyUsedIdle - Closes up to count idle connections, starting with
+// the least recently used, and returns how many were closed
+//
+// This is used to stay within max_collector_connections when connections are
+// opened outside of the pool (e.g. for schema collection), since idle connections
+// count towards that limit as well.
+func (p *ConnectionPool) CloseLeastRecentlyUsedIdle(count int) int {
+	if p == nil {
+		return 0
+	}
+	p.mutex.Lock()
+	var evicted []*PooledConnection
+	for len(evicted) < count {
+		oldest := p.leastRecentlyUsedIdle()
+		if oldest == nil {
+			break
+		}
+		p.removeIdle(oldest)
+		evicted = append(evicted, oldest)
+	}
+	p.mutex.Unlock()
+
+	closeConnections(evicted)
+	return len(evicted)
+}
+
+// CloseAll - Closes all idle connections, and ensures connections currently in
+// use get closed once they are returned
+func (p *ConnectionPool) CloseAll() {
+	if p == nil {
+		return
+	}
+	p.mutex.Lock()
+	var idle []*PooledConnection
+	for _, conns := range p.idle {
+		idle = append(idle, conns...)
+	}
+	p.idle = make(map[string][]*PooledConnection)
+	for _, conn := range p.inUse {
+		conn.unpooled = true
+	}
+	p.mutex.Unlock()
+
+	closeConnections(idle)
+}
+
+// CloseCreatedBefore - Closes idle connections established before the cutoff
+// (e.g. with credentials that were replaced since), ensures such connections
+// currently in use get closed once they are returned, and reports whether any
+// are still in use
+func (p *ConnectionPool) CloseCreatedBefore(cutoff time.Time) (inUse bool) {
+	if p == nil {
+		return false
+	}
+	p.mutex.Lock()
+	var old []*PooledConnection
+	for _, conns := range p.idle {
+		for _, conn := range conns {
+			if conn.CreatedAt.Before(cutoff) {
+				old = append(old, conn)
+			}
+		}
+	}
+	for _, conn := range old {
+		p.removeIdle(conn)
+	}
+	for _, conn := range p.inUse {
+		if conn.CreatedAt.Before(cutoff) {
+			conn.unpooled = true
+			inUse = true
+		}
+	}
+	p.mutex.Unlock()
+
+	closeConnections(old)
+	return inUse
+}
+
+// Stats - Returns the number of idle connections and connections in use
+func (p *ConnectionPool) Stats() (idle int, inUse int) {
+	if p == nil {
+		return 0, 0
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.idleCount(), len(p.inUse)
+}
+
+func (p *ConnectionPool) expired(conn *PooledConnection, now time.Time) bool {
+	return now.Sub(conn.LastUsedAt) > p.idleTimeout || now.Sub(conn.CreatedAt) > connectionPoolMaxLifetime
+}
+
+func (p *ConnectionPool) openCount() int {
+	return p.idleCount() + len(p.inUse)
+}
+
+func (p *ConnectionPool) idleCount() int {
+	count := 0
+	for _, conns := range p.idle {
+		count += len(conns)
+	}
+	return count
+}
+
+func (p *ConnectionPool) leastRecentlyUsedIdle() *PooledConnection {
+	var oldest *PooledConnection
+	for _, conns := range p.idle {
+		for _, conn := range conns {
+			if oldest == nil || conn.LastUsedAt.Before(oldest.LastUsedAt) {
+				oldest = conn
+			}
+		}
+	}
+	return oldest
+}
+
+func (p *ConnectionPool) removeIdle(conn *PooledConnection) {
+	conns := p.idle[conn.DatabaseName]
+	for idx, c := range conns {
+		if c == conn {
+			p.idle[conn.DatabaseName] = append(conns[:idx:idx], conns[idx+1:]...)
+			break
+		}
+	}
+	if len(p.idle[conn.DatabaseName]) == 0 {
+		delete(p.idle, conn.DatabaseName)
+	}
+}
+
+func closeConnections(conns []*PooledConnection) {
+	for _, conn := range conns {
+		conn.DB.Close()
+	}
+}
@@ -0,0 +1,135 @@
+package state
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testConnector struct{}
+
+func (testConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("not supported")
+}
+
+func (testConnector) Driver() driver.Driver { return nil }
+
+func TestConnectionPoolReuse(t *testing.T) {
+	pool := NewConnectionPool(4, time.Minute)
+	now := time.Now()
+
+	if conn := pool.Get("db1", now); conn != nil {
+		t.Fatalf("Get on empty pool: want nil; got %v", conn)
+	}
+
+	db := sql.OpenDB(testConnector{})
+	pool.Add(db, "db1", 0, false, now)
+	if idle, inUse := pool.Stats(); idle != 0 || inUse != 1 {
+		t.Errorf("after Add: want 0 idle, 1 in use; got %d, %d", idle, inUse)
+	}
+
+	pool.Put(db, now)
+	if conn := pool.Get("db2", now); conn != nil {
+		t.Errorf("Get for other database: want nil; got %v", conn)
+	}
+	conn := pool.Get("db1", now.Add(30*time.Second))
+	if conn == nil || conn.DB != db {
+		t.Fatalf("Get: want pooled connection; got %v", conn)
+	}
+	if conn := pool.Get("db1", now); conn != nil {
+		t.Errorf("Get while in use: want nil; got %v", conn)
+	}
+
+	pool.Put(db, now.Add(time.Minute))
+	if conn := pool.Get("db1", now.Add(3*time.Minute)); conn != nil {
+		t.Errorf("Get after idle timeout: want nil; got %v", conn)
+	}
+	if idle, inUse := pool.Stats(); idle != 0 || inUse != 0 {
+		t.Errorf("after idle timeout: want 0 idle, 0 in use; got %d, %d", idle, inUse)
+	}
+}
+
+func TestConnectionPoolLimits(t *testing.T) {
+	pool := NewConnectionPool(4, time.Minute)
+	now := time.Now()
+
+	var dbs []*sql.DB
+	for i := 0; i < 5; i++ {
+		db := sql.OpenDB(testConnector{})
+		pool.Add(db, "db1", 0, false, now.Add(time.Duration(i)*time.Second))
+		dbs = append(dbs, db)
+	}
+	if idle, inUse := pool.Stats(); idle != 0 || inUse != 5 {
+		t.Errorf("after Add: want 0 idle, 5 in use; got %d, %d", idle, inUse)
+	}
+
+	// Only half of the maximum connections are kept while idle, and the one that
+	// was opened beyond the maximum is not kept at all
+	for i, db := range dbs {
+		pool.Put(db, now.Add(time.Duration(i)*time.Second))
+	}
+	if idle, inUse := pool.Stats(); idle != 2 || inUse != 0 {
+		t.Errorf("after Put: want 2 idle, 0 in use; got %d, %d", idle, inUse)
+	}
+	if conn := pool.Get("db1", now.Add(5*time.Second)); conn == nil || conn.DB != dbs[3] {
+		t.Errorf("Get: want most recently used connection; got %v", conn)
+	}
+
+	// Adding connections to other databases closes the least recently used idle ones
+	for i := 0; i < 3; i++ {
+		pool.Add(sql.OpenDB(testConnector{}), "db2", 0, false, now.Add(10*time.Second))
+	}
+	if idle, inUse := pool.Stats(); idle != 0 || inUse != 4 {
+		t.Errorf("after Add to other database: want 0 idle, 4 in use; got %d, %d", idle, inUse)
+	}
+
+	// Idle connections are closed to make room for connections outside the pool
+	pool.Put(dbs[3], now.Add(15*time.Second))
+	if closed := pool.CloseLeastRecentlyUsedIdle(2); closed != 1 {
+		t.Errorf("CloseLeastRecentlyUsedIdle: want 1 closed; got %d", closed)
+	}
+	if idle, inUse := pool.Stats(); idle != 0 || inUse != 3 {
+		t.Errorf("after CloseLeastRecentlyUsedIdle: want 0 idle, 3 in use; got %d, %d", idle, inUse)
+	}
+	dbs[3] = sql.OpenDB(testConnector{})
+	pool.Add(dbs[3], "db1", 0, false, now.Add(15*time.Second))
+
+	pool.CloseAll()
+	pool.Put(dbs[3], now.Add(20*time.Second))
+	if idle, inUse := pool.Stats(); idle != 0 || inUse != 3 {
+		t.Errorf("after CloseAll: want 0 idle, 3 in use; got %d, %d", idle, inUse)
+	}
+}
+
+func TestConnectionPoolCloseCreatedBefore(t *testing.T) {
+	pool := NewConnectionPool(4, time.Minute)
+	now := time.Now()
+
+	oldIdle := sql.OpenDB(testConnector{})
+	oldInUse := sql.OpenDB(testConnector{})
+	newIdle := sql.OpenDB(testConnector{})
+	pool.Add(oldIdle, "db1", 0, false, now)
+	pool.Add(oldInUse, "db1", 0, false, now)
+	pool.Add(newIdle, "db1", 0, false, now.Add(10*time.Second))
+	pool.Put(oldIdle, now.Add(20*time.Second))
+	pool.Put(newIdle, now.Add(20*time.Second))
+
+	if inUse := pool.CloseCreatedBefore(now.Add(5 * time.Second)); !inUse {
+		t.Errorf("want old connection in use; got none")
+	}
+	if idle, inUse := pool.Stats(); idle != 1 || inUse != 1 {
+		t.Errorf("after CloseCreatedBefore: want 1 idle, 1 in use; got %d, %d", idle, inUse)
+	}
+
+	// The old connection is closed once returned, instead of being reused
+	pool.Put(oldInUse, now.Add(30*time.Second))
+	if inUse := pool.CloseCreatedBefore(now.Add(5 * time.Second)); inUse {
+		t.Errorf("want no old connection in use; got one")
+	}
+	if conn := pool.Get("db1", now.Add(40*time.Second)); conn == nil || conn.DB != newIdle {
+		t.Errorf("want connection created after cutoff to be kept; got %v", conn)
+	}
+}
@@ -0,0 +1,199 @@
+package state
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// LogArchive - Local archive of analyzed log lines, as newline-delimited JSON
+// files that can be ingested by a SIEM or kept for long-term retention
+//
+// Data is written by a background goroutine, so that a slow disk never delays
+// sending logs to pganalyze - if the queue is full, lines are dropped instead.
+// Each file is named by the time it was started, and a new file is started once
+// the current one reaches the maximum size or the rotate interval.
+type LogArchive struct {
+	dir            string
+	maxFileSize    int64
+	rotateInterval time.Duration
+	maxFiles       int
+
+	queue   chan logArchiveBatch
+	dropped atomic.Int64
+
+	// Only accessed by the writer goroutine
+	file          *os.File
+	fileSize      int64
+	fileStartedAt time.Time
+}
+
+type logArchiveBatch struct {
+	data  []byte
+	lines int
+}
+
+const logArchiveQueueLen = 100
+const logArchivePrefix = "pganalyze-logs-"
+const logArchiveSuffix = ".ndjson"
+
+// Fixed width, so that sorting file names sorts them by time
+const logArchiveTimeFormat = "20060102T150405.000000000Z"
+
+func NewLogArchive(baseDir string, sectionName string, maxFileSize int64, rotateInterval time.Duration, maxFiles int) *LogArchive {
+	return &LogArchive{
+		dir:            filepath.Join(baseDir, sectionDirName(sectionName)),
+		maxFileSize:    maxFileSize,
+		rotateInterval: rotateInterval,
+		maxFiles:       maxFiles,
+		queue:          make(chan logArchiveBatch, logArchiveQueueLen),
+	}
+}
+
+func (a *LogArchive) Dir() string {
+	return a.dir
+}
+
+// Add - Queues newline-delimited JSON data containing the given number of lines
+// to be written, without waiting for the write
+//
+// Returns false if the queue is full, in which case the lines are dropped.
+func (a *LogArchive) Add(data []byte, lines int) bool {
+	select {
+	case a.queue <- logArchiveBatch{data: data, lines: lines}:
+		return true
+	default:
+		a.dropped.Add(int64(lines))
+		return false
+	}
+}
+
+// DroppedLines - Returns the number of lines that could not be archived since
+// the collector started, due to a full queue or write errors
+func (a *LogArchive) DroppedLines() int64 {
+	return a.dropped.Load()
+}
+
+// Start - Starts writing queued data in the background, until the context is
+// canceled (data still queued at that point is written before returning)
+func (a *LogArchive) Start(ctx context.Context, wg *sync.WaitGroup, logger *util.Logger) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer a.closeFile(logger)
+
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				for {
+					select {
+					case batch := <-a.queue:
+						a.writeBatch(batch, time.Now(), logger)
+					default:
+						return
+					}
+				}
+			case batch := <-a.queue:
+				a.writeBatch(batch, time.Now(), logger)
+			case now := <-ticker.C:
+				// Close idle files once they are due for rotation, so they can be picked up
+				if a.file != nil && now.Sub(a.fileStartedAt) >= a.rotateInterval {
+					a.closeFile(logger)
+				}
+			}
+		}
+	}()
+}
+
+func (a *LogArchive) writeBatch(batch logArchiveBatch, now time.Time, logger *util.Logger) {
+	err := a.write(batch.data, now)
+	if err != nil {
+		logger.PrintError("Could not write to log archive: %s", err)
+		a.dropped.Add(int64(batch.lines))
+	}
+}
+
+func (a *LogArchive) write(data []byte, now time.Time) error {
+	if a.file != nil && ((a.fileSize > 0 && a.fileSize+int64(len(data)) > a.maxFileSize) || now.Sub(a.fileStartedAt) >= a.rotateInterval) {
+		err := a.file.Close()
+		a.file = nil
+		if err != nil {
+			return err
+		}
+	}
+	if a.file == nil {
+		err := a.startFile(now)
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := a.file.Write(data)
+	a.fileSize += int64(n)
+	return err
+}
+
+func (a *LogArchive) startFile(now time.Time) error {
+	err := os.MkdirAll(a.dir, 0700)
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(a.dir, logArchivePrefix+now.UTC().Format(logArchiveTimeFormat)+logArchiveSuffix)
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	a.file = file
+	a.fileSize = 0
+	a.fileStartedAt = now
+
+	return a.removeOldFiles()
+}
+
+// removeOldFiles removes the oldest files beyond the maximum number of files
+// (including the current file)
+func (a *LogArchive) removeOldFiles() error {
+	if a.maxFiles <= 0 {
+		return nil
+	}
+	dirEntries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return err
+	}
+	var filenames []string
+	for _, dirEntry := range dirEntries {
+		if strings.HasPrefix(dirEntry.Name(), logArchivePrefix) && strings.HasSuffix(dirEntry.Name(), logArchiveSuffix) {
+			filenames = append(filenames, dirEntry.Name())
+		}
+	}
+	sort.Strings(filenames)
+	for len(filenames) > a.maxFiles {
+		err = os.Remove(filepath.Join(a.dir, filenames[0]))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		filenames = filenames[1:]
+	}
+	return nil
+}
+
+func (a *LogArchive) closeFile(logger *util.Logger) {
+	if a.file == nil {
+		return
+	}
+	err := a.file.Close()
+	if err != nil {
+		logger.PrintError("Could not close log archive file: %s", err)
+	}
+	a.file = nil
+}
@@ -0,0 +1,108 @@
+package state
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+func readLogArchive(t *testing.T, archive *LogArchive) []string {
+	dirEntries, err := os.ReadDir(archive.Dir())
+	if err != nil {
+		t.Fatalf("ReadDir: %s", err)
+	}
+	var contents []string
+	for _, dirEntry := range dirEntries {
+		data, err := os.ReadFile(filepath.Join(archive.Dir(), dirEntry.Name()))
+		if err != nil {
+			t.Fatalf("ReadFile: %s", err)
+		}
+		contents = append(contents, string(data))
+	}
+	sort.Strings(contents)
+	return contents
+}
+
+func TestLogArchiveRotation(t *testing.T) {
+	archive := NewLogArchive(t.TempDir(), "server/1", 10, time.Hour, 0)
+	now := time.Now()
+
+	for _, item := range []struct {
+		data string
+		at   time.Time
+	}{
+		{"a1\na2\n", now},
+		{"a3\n", now.Add(time.Second)},
+		{"b1\nb2\n", now.Add(2 * time.Second)}, // Exceeds the maximum file size
+		{"c1\n", now.Add(2 * time.Hour)},       // Exceeds the rotate interval
+		{"d1\nd2\nd3\nd4\n", now.Add(3 * time.Hour)},
+	} {
+		err := archive.write([]byte(item.data), item.at)
+		if err != nil {
+			t.Fatalf("write: %s", err)
+		}
+	}
+	archive.closeFile(&util.Logger{})
+
+	contents := readLogArchive(t, archive)
+	expected := []string{"a1\na2\na3\n", "b1\nb2\n", "c1\n", "d1\nd2\nd3\nd4\n"}
+	if strings.Join(contents, "|") != strings.Join(expected, "|") {
+		t.Errorf("want files %q; got %q", expected, contents)
+	}
+	if !strings.HasPrefix(filepath.Base(archive.Dir()), "server_1-") {
+		t.Errorf("dir: want server_1-<hash>; got %s", filepath.Base(archive.Dir()))
+	}
+}
+
+func TestLogArchiveMaxFiles(t *testing.T) {
+	archive := NewLogArchive(t.TempDir(), "server", 1024, time.Minute, 2)
+	now := time.Now()
+	for idx, data := range []string{"first\n", "second\n", "third\n"} {
+		err := archive.write([]byte(data), now.Add(time.Duration(idx)*time.Hour))
+		if err != nil {
+			t.Fatalf("write: %s", err)
+		}
+	}
+	archive.closeFile(&util.Logger{})
+
+	contents := readLogArchive(t, archive)
+	if strings.Join(contents, "") != "second\nthird\n" {
+		t.Errorf("want the two newest files; got %q", contents)
+	}
+}
+
+func TestLogArchiveQueue(t *testing.T) {
+	archive := NewLogArchive(t.TempDir(), "server", 1024, time.Hour, 0)
+
+	// Without a running writer, lines are dropped once the queue is full
+	for i := 0; i < logArchiveQueueLen; i++ {
+		if !archive.Add([]byte("line\n"), 1) {
+			t.Fatalf("Add: want queued; got dropped after %d batches", i)
+		}
+	}
+	if archive.Add([]byte("dropped\ndropped\n"), 2) {
+		t.Errorf("Add: want dropped; got queued")
+	}
+	if archive.DroppedLines() != 2 {
+		t.Errorf("DroppedLines: want 2; got %d", archive.DroppedLines())
+	}
+
+	// Queued lines are written before the writer exits
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	cancel()
+	archive.Start(ctx, &wg, &util.Logger{})
+	wg.Wait()
+
+	contents := readLogArchive(t, archive)
+	if len(contents) != 1 || contents[0] != strings.Repeat("line\n", logArchiveQueueLen) {
+		t.Errorf("want all queued lines; got %q", contents)
+	}
+}
@@ -0,0 +1,255 @@
+package state
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/util"
+)
+
+// Maximum number of distinct queries (per database and user) tracked between
+// two full snapshots - queries seen for the first time after that are dropped
+const LogQueryStatsMaxEntries = 5000
+
+// Maximum number of query texts whose fingerprint is cached (repeated texts are
+// common with prepared statements, and fingerprinting is the expensive part)
+const logQueryStatsMaxCachedTexts = 10000
+
+// LogQueryStatsKey - Identifies a query in the log-derived statistics, using
+// names since the log output doesn't contain OIDs
+type LogQueryStatsKey struct {
+	Database    string
+	Username    string
+	Fingerprint uint64
+}
+
+type logQueryStatsEntry struct {
+	stats      DiffedPostgresStatementStats
+	timedCalls int64
+}
+
+type pendingLogStatement struct {
+	key        LogQueryStatsKey
+	generation uint64
+}
+
+// LogQueryStats - Aggregates per-query statistics from log_statement=all and
+// log_duration=on (or log_min_duration_statement=0) output, for servers where
+// pg_stat_statements is not available and the log is the only source.
+//
+// Note that Postgres does not log the number of rows for these lines, so Rows
+// is always zero, and calls whose duration is not logged only count as a call.
+type LogQueryStats struct {
+	filterQueryText string
+
+	lock         sync.Mutex
+	stats        map[LogQueryStatsKey]*logQueryStatsEntry
+	texts        PostgresStatementTextMap
+	fingerprints map[string]uint64
+	pending      map[int32]pendingLogStatement
+	generation   uint64
+	dropped      int64
+	since        time.Time
+}
+
+func NewLogQueryStats(filterQueryText string) *LogQueryStats {
+	return &LogQueryStats{
+		filterQueryText: filterQueryText,
+		stats:           make(map[LogQueryStatsKey]*logQueryStatsEntry),
+		texts:           make(PostgresStatementTextMap),
+		fingerprints:    make(map[string]uint64),
+		pending:         make(map[int32]pendingLogStatement),
+		since:           time.Now(),
+	}
+}
+
+// AddLogLine - Accumulates statistics for a log_statement/log_duration line,
+// any other log lines are ignored (it is safe to call this on a nil receiver)
+func (s *LogQueryStats) AddLogLine(logLine LogLine) {
+	if s == nil || logLine.LogLevel != pganalyze_collector.LogLineInformation_LOG {
+		return
+	}
+
+	content := strings.TrimSuffix(logLine.Content, "\n")
+	var durationMs float64
+	hasDuration := false
+	if rest, ok := strings.CutPrefix(content, "duration: "); ok {
+		durationStr, rest, ok := strings.Cut(rest, " ms")
+		if !ok {
+			return
+		}
+		var err error
+		durationMs, err = strconv.ParseFloat(durationStr, 64)
+		if err != nil {
+			return
+		}
+		hasDuration = true
+		content = strings.TrimPrefix(rest, "  ")
+	}
+
+	var query string
+	if rest, ok := strings.CutPrefix(content, "statement: "); ok {
+		query = rest
+	} else if rest, ok := strings.CutPrefix(content, "execute "); ok {
+		// Extended query protocol, e.g. "execute <unnamed>: SELECT ..."
+		_, query, ok = strings.Cut(rest, ": ")
+		if !ok {
+			return
+		}
+	} else if !hasDuration || content != "" {
+		// Other lines, including the parse/bind phases of the extended query protocol
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if query == "" {
+		// log_duration output without a statement belongs to the statement the
+		// same backend logged right before (when log_statement is enabled)
+		pending, ok := s.pending[logLine.BackendPid]
+		if ok {
+			delete(s.pending, logLine.BackendPid)
+			s.record(pending.key, durationMs, true)
+		}
+		return
+	}
+
+	key := LogQueryStatsKey{
+		Database:    logLine.Database,
+		Username:    logLine.Username,
+		Fingerprint: s.fingerprint(query),
+	}
+	if hasDuration || logLine.BackendPid == 0 {
+		s.record(key, durationMs, hasDuration)
+		return
+	}
+	if pending, ok := s.pending[logLine.BackendPid]; ok {
+		s.record(pending.key, 0, false)
+	} else if len(s.pending) >= LogQueryStatsMaxEntries {
+		s.record(key, 0, false)
+		return
+	}
+	s.pending[logLine.BackendPid] = pendingLogStatement{key: key, generation: s.generation}
+}
+
+func (s *LogQueryStats) fingerprint(query string) uint64 {
+	fingerprint, ok := s.fingerprints[query]
+	if ok {
+		return fingerprint
+	}
+	fingerprint = util.FingerprintQuery(query, s.filterQueryText, -1)
+	if _, ok := s.texts[fingerprint]; !ok && len(s.texts) < logQueryStatsMaxCachedTexts {
+		s.texts[fingerprint] = util.NormalizeQuery(query, s.filterQueryText, -1)
+	}
+	if len(s.fingerprints) >= logQueryStatsMaxCachedTexts {
+		clear(s.fingerprints)
+	}
+	s.fingerprints[query] = fingerprint
+	return fingerprint
+}
+
+func (s *LogQueryStats) record(key LogQueryStatsKey, durationMs float64, hasDuration bool) {
+	entry, ok := s.stats[key]
+	if !ok {
+		if len(s.stats) >= LogQueryStatsMaxEntries {
+			s.dropped++
+			return
+		}
+		entry = &logQueryStatsEntry{}
+		s.stats[key] = entry
+	}
+	entry.stats.Calls++
+	if !hasDuration {
+		return
+	}
+	if entry.timedCalls == 0 || durationMs < entry.stats.MinTime {
+		entry.stats.MinTime = durationMs
+	}
+	if durationMs > entry.stats.MaxTime {
+		entry.stats.MaxTime = durationMs
+	}
+	entry.stats.TotalTime += durationMs
+	entry.timedCalls++
+}
+
+// Drain - Returns the statistics accumulated since the last call, in the same
+// form as pg_stat_statements data, and resets them. Database and user names
+// are resolved to OIDs using the given databases and roles, and queries
+// without a match are skipped. Also returns the number of calls that were
+// dropped since there were too many distinct queries.
+//
+// Since there is no query ID, the fingerprint is used in its place.
+func (s *LogQueryStats) Drain(now time.Time, databases []PostgresDatabase, roles []PostgresRole) (HistoricStatementStatsMap, PostgresStatementMap, PostgresStatementTextMap, int64) {
+	databaseOids := make(map[string]Oid, len(databases))
+	for _, database := range databases {
+		databaseOids[database.Name] = database.Oid
+	}
+	roleOids := make(map[string]Oid, len(roles))
+	for _, role := range roles {
+		roleOids[role.Name] = role.Oid
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Statements whose duration never showed up (e.g. the backend exited, or
+	// log_duration is off) are counted as a call without a duration
+	for pid, pending := range s.pending {
+		if pending.generation < s.generation {
+			s.record(pending.key, 0, false)
+			delete(s.pending, pid)
+		}
+	}
+	s.generation++
+
+	statementStats := make(DiffedPostgresStatementStatsMap)
+	statements := make(PostgresStatementMap)
+	texts := make(PostgresStatementTextMap)
+	for key, entry := range s.stats {
+		databaseOid, ok := databaseOids[key.Database]
+		if !ok {
+			continue
+		}
+		userOid, ok := roleOids[key.Username]
+		if !ok {
+			continue
+		}
+		if entry.timedCalls > 0 {
+			entry.stats.MeanTime = entry.stats.TotalTime / float64(entry.timedCalls)
+		}
+		statementKey := PostgresStatementKey{DatabaseOid: databaseOid, UserOid: userOid, QueryID: int64(key.Fingerprint), Toplevel: true}
+		statementStats[statementKey] = entry.stats
+		if text, ok := s.texts[key.Fingerprint]; ok {
+			statements[statementKey] = PostgresStatement{Fingerprint: key.Fingerprint}
+			texts[key.Fingerprint] = text
+		} else {
+			statements[statementKey] = PostgresStatement{Fingerprint: key.Fingerprint, QueryTextUnavailable: true}
+		}
+	}
+
+	timeKey := HistoricStatsTimeKey{CollectedAt: now, CollectedIntervalSecs: uint32(now.Sub(s.since) / time.Second)}
+	dropped := s.dropped
+	s.stats = make(map[LogQueryStatsKey]*logQueryStatsEntry)
+	s.dropped = 0
+
+	// Start over with the query texts (and the fingerprint cache that refers to
+	// them), only keeping those still needed for pending statements
+	pendingTexts := make(PostgresStatementTextMap)
+	for _, pending := range s.pending {
+		if text, ok := s.texts[pending.key.Fingerprint]; ok {
+			pendingTexts[pending.key.Fingerprint] = text
+		}
+	}
+	s.texts = pendingTexts
+	clear(s.fingerprints)
+	s.since = now
+
+	if len(statementStats) == 0 {
+		return nil, statements, texts, dropped
+	}
+	return HistoricStatementStatsMap{timeKey: statementStats}, statements, texts, dropped
+}
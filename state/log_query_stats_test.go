@@ -0,0 +1,118 @@
+package state
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/util"
+)
+
+func logQueryStatsLine(pid int32, username string, content string) LogLine {
+	return LogLine{
+		LogLevel:   pganalyze_collector.LogLineInformation_LOG,
+		BackendPid: pid,
+		Database:   "app",
+		Username:   username,
+		Content:    content + "\n",
+	}
+}
+
+func TestLogQueryStats(t *testing.T) {
+	stats := NewLogQueryStats("unparsable")
+	for _, logLine := range []LogLine{
+		// log_statement=all together with log_duration=on
+		logQueryStatsLine(101, "alice", "statement: SELECT * FROM users WHERE id = 1"),
+		logQueryStatsLine(102, "alice", "statement: SELECT * FROM users WHERE id = 2"),
+		logQueryStatsLine(102, "alice", "duration: 4.000 ms"),
+		logQueryStatsLine(101, "alice", "duration: 2.000 ms"),
+		// Extended query protocol (durations of the parse/bind phases are ignored)
+		logQueryStatsLine(103, "alice", "duration: 0.100 ms"),
+		logQueryStatsLine(103, "alice", "execute <unnamed>: SELECT * FROM users WHERE id = $1"),
+		logQueryStatsLine(103, "alice", "duration: 6.000 ms"),
+		// log_min_duration_statement=0
+		logQueryStatsLine(104, "bob", "duration: 1.500 ms  statement: SELECT * FROM users WHERE id = 3"),
+		logQueryStatsLine(104, "bob", "duration: 0.200 ms  parse <unnamed>: SELECT 1"),
+		// Statement without a duration (e.g. log_duration=off), and unrelated lines
+		logQueryStatsLine(105, "bob", "statement: SELECT * FROM users WHERE id = 4"),
+		logQueryStatsLine(105, "bob", "connection authorized: user=bob database=app"),
+		logQueryStatsLine(106, "unknown", "duration: 1.000 ms  statement: SELECT * FROM users WHERE id = 5"),
+		{LogLevel: pganalyze_collector.LogLineInformation_STATEMENT, BackendPid: 107, Database: "app", Username: "bob", Content: "statement: SELECT 1"},
+	} {
+		stats.AddLogLine(logLine)
+	}
+
+	databases := []PostgresDatabase{{Oid: 1, Name: "app"}}
+	roles := []PostgresRole{{Oid: 10, Name: "alice"}, {Oid: 11, Name: "bob"}}
+	now := time.Now()
+	statementStats, statements, texts, dropped := stats.Drain(now, databases, roles)
+	if dropped != 0 {
+		t.Errorf("dropped: want 0; got %d", dropped)
+	}
+	if len(statementStats) != 1 {
+		t.Fatalf("want 1 time key; got %d", len(statementStats))
+	}
+
+	fingerprint := util.FingerprintQuery("SELECT * FROM users WHERE id = 1", "unparsable", -1)
+	aliceKey := PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: int64(fingerprint), Toplevel: true}
+	bobKey := PostgresStatementKey{DatabaseOid: 1, UserOid: 11, QueryID: int64(fingerprint), Toplevel: true}
+	for _, diffedStats := range statementStats {
+		if len(diffedStats) != 2 {
+			t.Errorf("want 2 entries; got %+v", diffedStats)
+		}
+		alice := diffedStats[aliceKey]
+		if alice.Calls != 3 || alice.TotalTime != 12 || alice.MinTime != 2 || alice.MaxTime != 6 || alice.MeanTime != 4 {
+			t.Errorf("alice: unexpected stats %+v", alice)
+		}
+		// The statement without a duration stays pending until the next drain
+		bob := diffedStats[bobKey]
+		if bob.Calls != 1 || bob.TotalTime != 1.5 || bob.MinTime != 1.5 || bob.MaxTime != 1.5 {
+			t.Errorf("bob: unexpected stats %+v", bob)
+		}
+	}
+	if statements[aliceKey].Fingerprint != fingerprint || statements[aliceKey].QueryTextUnavailable {
+		t.Errorf("want statement with fingerprint; got %+v", statements[aliceKey])
+	}
+	if texts[fingerprint] != "SELECT * FROM users WHERE id = $1" {
+		t.Errorf("want normalized query text; got %q", texts[fingerprint])
+	}
+
+	statementStats, _, texts, _ = stats.Drain(now.Add(10*time.Minute), databases, roles)
+	for timeKey, diffedStats := range statementStats {
+		if timeKey.CollectedIntervalSecs != 600 {
+			t.Errorf("want interval of 600 seconds; got %d", timeKey.CollectedIntervalSecs)
+		}
+		bob := diffedStats[bobKey]
+		if len(diffedStats) != 1 || bob.Calls != 1 || bob.TotalTime != 0 {
+			t.Errorf("want pending statement to be counted without duration; got %+v", diffedStats)
+		}
+	}
+	if len(statementStats) != 1 || texts[fingerprint] == "" {
+		t.Errorf("want pending statement with query text; got %+v, %+v", statementStats, texts)
+	}
+}
+
+func TestLogQueryStatsMaxEntries(t *testing.T) {
+	stats := NewLogQueryStats("unparsable")
+	for i := 0; i < LogQueryStatsMaxEntries+10; i++ {
+		// Fingerprints ignore digits in table names, so use letters instead
+		table := strings.Map(func(r rune) rune { return r - '0' + 'a' }, strconv.Itoa(i))
+		stats.AddLogLine(logQueryStatsLine(101, "alice", "duration: 1.000 ms  statement: SELECT * FROM table_"+table))
+	}
+	statementStats, _, _, dropped := stats.Drain(time.Now(), []PostgresDatabase{{Oid: 1, Name: "app"}}, []PostgresRole{{Oid: 10, Name: "alice"}})
+	if dropped != 10 {
+		t.Errorf("dropped: want 10; got %d", dropped)
+	}
+	for _, diffedStats := range statementStats {
+		if len(diffedStats) != LogQueryStatsMaxEntries {
+			t.Errorf("want %d entries; got %d", LogQueryStatsMaxEntries, len(diffedStats))
+		}
+	}
+}
+
+func TestLogQueryStatsNil(t *testing.T) {
+	var stats *LogQueryStats
+	stats.AddLogLine(logQueryStatsLine(101, "alice", "statement: SELECT 1"))
+}
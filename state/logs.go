@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -31,6 +32,10 @@ type PersistedLogState struct {
 	// all other markers are discarded
 	AwsMarkers map[string]string
 
+	// Tokens for reading RDS log streams exported to CloudWatch Logs (keyed by
+	// log stream name), only kept for streams that received recent writes
+	AwsCloudWatchTokens map[string]string
+
 	// Markers for pg_read_file-based access
 	ReadFileMarkers map[string]int64
 
@@ -41,6 +46,11 @@ type PersistedLogState struct {
 		Signature     string
 		Expiry        int64
 	}
+
+	// Aiven state
+	Aiven struct {
+		Offset string
+	}
 }
 
 // LogFile - Log file that we are uploading for reference in log line metadata
@@ -81,9 +91,13 @@ const (
 
 	// UnidentifiedLogSecret - Text that could not be identified and might contain secrets
 	UnidentifiedLogSecret
+
+	// CustomLogSecret - Text matching one of the user-defined filter_log_rules
+	CustomLogSecret
 )
 
-// AllLogSecretKinds - List of all defined secret kinds
+// AllLogSecretKinds - List of all secret kinds that can be filtered using
+// filter_log_secret, named by config.FilterLogSecretKinds
 var AllLogSecretKinds = []LogSecretKind{
 	CredentialLogSecret,
 	ParsingErrorLogSecret,
@@ -95,24 +109,12 @@ var AllLogSecretKinds = []LogSecretKind{
 }
 
 func ParseFilterLogSecret(input string) (result []LogSecretKind) {
-	for _, kind := range strings.Split(input, ",") {
-		switch strings.TrimSpace(kind) {
-		case "credential":
-			result = append(result, CredentialLogSecret)
-		case "parsing_error":
-			result = append(result, ParsingErrorLogSecret)
-		case "statement_text":
-			result = append(result, StatementTextLogSecret)
-		case "statement_parameter":
-			result = append(result, StatementParameterLogSecret)
-		case "table_data":
-			result = append(result, TableDataLogSecret)
-		case "ops":
-			result = append(result, OpsLogSecret)
-		case "unidentified":
-			result = append(result, UnidentifiedLogSecret)
-		case "all":
+	for _, name := range strings.Split(input, ",") {
+		name = strings.TrimSpace(name)
+		if name == "all" {
 			result = AllLogSecretKinds
+		} else if idx := slices.Index(config.FilterLogSecretKinds, name); idx != -1 {
+			result = append(result, AllLogSecretKinds[idx])
 		}
 	}
 	return result
@@ -0,0 +1,56 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pganalyze/collector/config"
+)
+
+var parseFilterLogSecretTests = []struct {
+	input    string
+	expected []LogSecretKind
+}{
+	{
+		"",
+		nil,
+	},
+	{
+		"none",
+		nil,
+	},
+	{
+		"credential, unidentified",
+		[]LogSecretKind{CredentialLogSecret, UnidentifiedLogSecret},
+	},
+	{
+		"statement_text,statement_parameter,ops",
+		[]LogSecretKind{StatementTextLogSecret, StatementParameterLogSecret, OpsLogSecret},
+	},
+	{
+		"all",
+		AllLogSecretKinds,
+	},
+}
+
+func TestParseFilterLogSecret(t *testing.T) {
+	for _, test := range parseFilterLogSecretTests {
+		actual := ParseFilterLogSecret(test.input)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("ParseFilterLogSecret(%q): expected %v, got %v", test.input, test.expected, actual)
+		}
+	}
+}
+
+func TestFilterLogSecretKindNames(t *testing.T) {
+	if len(config.FilterLogSecretKinds) != len(AllLogSecretKinds) {
+		t.Fatalf("expected %d filter_log_secret kind names, got %d", len(AllLogSecretKinds), len(config.FilterLogSecretKinds))
+	}
+	for _, kind := range AllLogSecretKinds {
+		name := config.FilterLogSecretKinds[kind-1]
+		actual := ParseFilterLogSecret(name)
+		if !reflect.DeepEqual(actual, []LogSecretKind{kind}) {
+			t.Errorf("ParseFilterLogSecret(%q): expected %v, got %v", name, []LogSecretKind{kind}, actual)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresLock - A lock held or awaited by a backend, as reported by pg_locks
+//
+// See https://www.postgresql.org/docs/current/view-pg-locks.html
+type PostgresLock struct {
+	Pid           int32       // Process ID of the backend holding or awaiting this lock
+	LockType      string      // Type of the lockable object (e.g. relation, tuple, transactionid)
+	DatabaseName  null.String // Name of the database in which the lock target exists, if any
+	RelationOid   null.Int    // OID of the relation targeted by the lock, if any
+	SchemaName    null.String // Schema of the targeted relation (only known for the current database)
+	RelationName  null.String // Name of the targeted relation (only known for the current database)
+	Page          null.Int    // Page number targeted by the lock within the relation, if any
+	Tuple         null.Int    // Tuple number targeted by the lock within the page, if any
+	VirtualXid    null.String // Virtual ID of the transaction targeted by the lock, if any
+	TransactionId null.Int    // ID of the transaction targeted by the lock, if any
+	Mode          string      // Name of the lock mode held or desired (e.g. AccessExclusiveLock)
+	Granted       bool        // True if lock is held, false if lock is awaited
+	Fastpath      bool        // True if lock was taken via fast path, false if taken via main lock table
+}
+
+// PostgresLockWait - A backend waiting on a lock, and the chain of backends blocking it
+type PostgresLockWait struct {
+	Pid             int32
+	BlockedByPids   []int32 // Backends directly blocking this backend
+	RootBlockerPids []int32 // Backends at the start of the chain(s), that are not blocked themselves
+	ChainDepth      int32   // Length of the longest chain to a root blocker (1 if directly blocked by one)
+	Deadlock        bool    // Whether the chain contains a cycle
+}
@@ -20,6 +20,11 @@ type PostgresReplication struct {
 	ApplyByteLag       null.Int
 	ReplayTimestamp    null.Time
 	ReplayTimestampAge null.Int
+
+	// Logical replication (slots can exist on both primary and standby)
+	ReplicationSlots []PostgresReplicationSlot
+	Publications     []PostgresPublication
+	Subscriptions    []PostgresSubscription
 }
 
 // PostgresReplicationStandby - Standby information as seen from the primary
@@ -43,3 +48,82 @@ type PostgresReplicationStandby struct {
 	RemoteByteLag  null.Int
 	LocalByteLag   null.Int
 }
+
+// PostgresReplicationSlot - Physical or logical replication slot, and for logical
+// slots the decoding statistics (from pg_stat_replication_slots, in Postgres 14+)
+type PostgresReplicationSlot struct {
+	SlotName    string
+	Plugin      null.String
+	SlotType    string // "physical" or "logical"
+	DatabaseOid Oid    // 0 for physical slots
+	Temporary   bool
+	Active      bool
+	ActivePid   null.Int
+
+	RestartLsn        null.String
+	ConfirmedFlushLsn null.String
+
+	// Bytes of WAL between the current WAL position and the slot position (the
+	// restart LSN determines how much WAL is retained for the slot)
+	RestartLsnByteLag        null.Int
+	ConfirmedFlushLsnByteLag null.Int
+
+	WalStatus   null.String // Postgres 13+
+	SafeWalSize null.Int    // Postgres 13+
+
+	// Cumulative logical decoding statistics (Postgres 14+)
+	SpillTxns   int64
+	SpillCount  int64
+	SpillBytes  int64
+	StreamTxns  int64
+	StreamCount int64
+	StreamBytes int64
+	TotalTxns   int64
+	TotalBytes  int64
+	StatsReset  null.Time
+}
+
+// PostgresPublication - Logical replication publication in a database
+type PostgresPublication struct {
+	DatabaseOid Oid
+	Name        string
+	AllTables   bool
+	Insert      bool
+	Update      bool
+	Delete      bool
+	Truncate    bool // Postgres 11+
+	ViaRoot     bool // Postgres 13+
+
+	// Tables published, not collected for publications that include all tables
+	Tables []PostgresPublicationTable
+}
+
+// PostgresPublicationTable - Table that is part of a publication
+type PostgresPublicationTable struct {
+	SchemaName   string
+	RelationName string
+}
+
+// PostgresSubscription - Logical replication subscription, as seen from the subscriber
+type PostgresSubscription struct {
+	Oid         Oid
+	Name        string
+	DatabaseOid Oid
+	Enabled     bool
+
+	// Apply worker status (unset if the apply worker is not running)
+	Pid                null.Int
+	ReceivedLsn        null.String
+	LatestEndLsn       null.String
+	LastMsgSendTime    null.Time
+	LastMsgReceiptTime null.Time
+	LatestEndTime      null.Time
+
+	// Number of table synchronization workers currently running
+	SyncWorkers int32
+
+	// Cumulative error counts (Postgres 15+)
+	ApplyErrorCount int64
+	SyncErrorCount  int64
+	StatsReset      null.Time
+}
@@ -0,0 +1,223 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresServerSharedStats - Server-wide cumulative statistics for WAL generation,
+// checkpoints, the background writer, WAL archiving and SLRU caches
+//
+// Each part is nil when not supported by the Postgres version. Each view has its
+// own stats_reset time, since they can be reset individually with
+// pg_stat_reset_shared() (or pg_stat_reset_slru()).
+type PostgresServerSharedStats struct {
+	Wal          *PostgresServerWalStats
+	Checkpointer *PostgresServerCheckpointerStats
+	Bgwriter     *PostgresServerBgwriterStats
+	Archiver     *PostgresServerArchiverStats
+	Slru         PostgresServerSlruStatsMap
+}
+
+// PostgresServerWalStats - WAL statistics (from pg_stat_wal, in Postgres 14+)
+//
+// Write/sync counts and timings are no longer available in Postgres 18+ (they
+// moved to pg_stat_io) and are zero in that case.
+type PostgresServerWalStats struct {
+	Records     int64
+	Fpi         int64
+	Bytes       int64
+	BuffersFull int64
+	Write       int64
+	Sync        int64
+	WriteTime   float64
+	SyncTime    float64
+	StatsReset  null.Time
+}
+
+// PostgresServerCheckpointerStats - Checkpointer statistics (from pg_stat_checkpointer
+// in Postgres 17+, and pg_stat_bgwriter in older versions)
+//
+// Restartpoint counts are only available in Postgres 17+, and completed checkpoints
+// and SLRU buffers written only in Postgres 18+.
+type PostgresServerCheckpointerStats struct {
+	NumTimed           int64
+	NumRequested       int64
+	NumDone            int64
+	RestartpointsTimed int64
+	RestartpointsReq   int64
+	RestartpointsDone  int64
+	WriteTime          float64
+	SyncTime           float64
+	BuffersWritten     int64
+	SlruWritten        int64
+	StatsReset         null.Time
+}
+
+// PostgresServerBgwriterStats - Background writer statistics (from pg_stat_bgwriter)
+//
+// Backend buffer writes and fsyncs are only available before Postgres 17 (they
+// moved to pg_stat_io).
+type PostgresServerBgwriterStats struct {
+	BuffersClean        int64
+	MaxwrittenClean     int64
+	BuffersAlloc        int64
+	BuffersBackend      int64
+	BuffersBackendFsync int64
+	StatsReset          null.Time
+}
+
+// PostgresServerArchiverStats - WAL archiver statistics (from pg_stat_archiver)
+type PostgresServerArchiverStats struct {
+	ArchivedCount    int64
+	LastArchivedWal  null.String
+	LastArchivedTime null.Time
+	FailedCount      int64
+	LastFailedWal    null.String
+	LastFailedTime   null.Time
+	StatsReset       null.Time
+}
+
+// PostgresServerSlruStats - SLRU cache statistics (from pg_stat_slru, in Postgres 13+)
+type PostgresServerSlruStats struct {
+	BlksZeroed  int64
+	BlksHit     int64
+	BlksRead    int64
+	BlksWritten int64
+	BlksExists  int64
+	Flushes     int64
+	Truncates   int64
+	StatsReset  null.Time
+}
+
+// PostgresServerSlruStatsMap - SLRU cache statistics, keyed by SLRU name
+type PostgresServerSlruStatsMap map[string]PostgresServerSlruStats
+
+type DiffedPostgresServerSharedStats PostgresServerSharedStats
+type DiffedPostgresServerWalStats PostgresServerWalStats
+type DiffedPostgresServerCheckpointerStats PostgresServerCheckpointerStats
+type DiffedPostgresServerBgwriterStats PostgresServerBgwriterStats
+type DiffedPostgresServerArchiverStats PostgresServerArchiverStats
+type DiffedPostgresServerSlruStats PostgresServerSlruStats
+
+type HistoricPostgresServerSharedStatsMap map[HistoricStatsTimeKey]DiffedPostgresServerSharedStats
+
+// statsResetSince - Whether the statistics were reset after the previous values were
+// collected, in which case the current values are the difference since the reset
+func statsResetSince(curr null.Time, prev null.Time) bool {
+	return curr.Valid != prev.Valid || (curr.Valid && !curr.Time.Equal(prev.Time))
+}
+
+// DiffSince - Calculates the difference to the previously collected statistics
+//
+// Parts that were not collected previously (e.g. on the first run) are left nil.
+func (curr PostgresServerSharedStats) DiffSince(prev PostgresServerSharedStats) DiffedPostgresServerSharedStats {
+	var diff DiffedPostgresServerSharedStats
+	if curr.Wal != nil && prev.Wal != nil {
+		d := PostgresServerWalStats(curr.Wal.DiffSince(*prev.Wal))
+		diff.Wal = &d
+	}
+	if curr.Checkpointer != nil && prev.Checkpointer != nil {
+		d := PostgresServerCheckpointerStats(curr.Checkpointer.DiffSince(*prev.Checkpointer))
+		diff.Checkpointer = &d
+	}
+	if curr.Bgwriter != nil && prev.Bgwriter != nil {
+		d := PostgresServerBgwriterStats(curr.Bgwriter.DiffSince(*prev.Bgwriter))
+		diff.Bgwriter = &d
+	}
+	if curr.Archiver != nil && prev.Archiver != nil {
+		d := PostgresServerArchiverStats(curr.Archiver.DiffSince(*prev.Archiver))
+		diff.Archiver = &d
+	}
+	if curr.Slru != nil && prev.Slru != nil {
+		diff.Slru = make(PostgresServerSlruStatsMap)
+		for name, stats := range curr.Slru {
+			prevStats, exists := prev.Slru[name]
+			if !exists { // New since the last run
+				prevStats = PostgresServerSlruStats{}
+			}
+			diff.Slru[name] = PostgresServerSlruStats(stats.DiffSince(prevStats))
+		}
+	}
+	return diff
+}
+
+func (curr PostgresServerWalStats) DiffSince(prev PostgresServerWalStats) DiffedPostgresServerWalStats {
+	if statsResetSince(curr.StatsReset, prev.StatsReset) {
+		prev = PostgresServerWalStats{}
+	}
+	return DiffedPostgresServerWalStats{
+		Records:     curr.Records - prev.Records,
+		Fpi:         curr.Fpi - prev.Fpi,
+		Bytes:       curr.Bytes - prev.Bytes,
+		BuffersFull: curr.BuffersFull - prev.BuffersFull,
+		Write:       curr.Write - prev.Write,
+		Sync:        curr.Sync - prev.Sync,
+		WriteTime:   curr.WriteTime - prev.WriteTime,
+		SyncTime:    curr.SyncTime - prev.SyncTime,
+		StatsReset:  curr.StatsReset,
+	}
+}
+
+func (curr PostgresServerCheckpointerStats) DiffSince(prev PostgresServerCheckpointerStats) DiffedPostgresServerCheckpointerStats {
+	if statsResetSince(curr.StatsReset, prev.StatsReset) {
+		prev = PostgresServerCheckpointerStats{}
+	}
+	return DiffedPostgresServerCheckpointerStats{
+		NumTimed:           curr.NumTimed - prev.NumTimed,
+		NumRequested:       curr.NumRequested - prev.NumRequested,
+		NumDone:            curr.NumDone - prev.NumDone,
+		RestartpointsTimed: curr.RestartpointsTimed - prev.RestartpointsTimed,
+		RestartpointsReq:   curr.RestartpointsReq - prev.RestartpointsReq,
+		RestartpointsDone:  curr.RestartpointsDone - prev.RestartpointsDone,
+		WriteTime:          curr.WriteTime - prev.WriteTime,
+		SyncTime:           curr.SyncTime - prev.SyncTime,
+		BuffersWritten:     curr.BuffersWritten - prev.BuffersWritten,
+		SlruWritten:        curr.SlruWritten - prev.SlruWritten,
+		StatsReset:         curr.StatsReset,
+	}
+}
+
+func (curr PostgresServerBgwriterStats) DiffSince(prev PostgresServerBgwriterStats) DiffedPostgresServerBgwriterStats {
+	if statsResetSince(curr.StatsReset, prev.StatsReset) {
+		prev = PostgresServerBgwriterStats{}
+	}
+	return DiffedPostgresServerBgwriterStats{
+		BuffersClean:        curr.BuffersClean - prev.BuffersClean,
+		MaxwrittenClean:     curr.MaxwrittenClean - prev.MaxwrittenClean,
+		BuffersAlloc:        curr.BuffersAlloc - prev.BuffersAlloc,
+		BuffersBackend:      curr.BuffersBackend - prev.BuffersBackend,
+		BuffersBackendFsync: curr.BuffersBackendFsync - prev.BuffersBackendFsync,
+		StatsReset:          curr.StatsReset,
+	}
+}
+
+// DiffSince - Calculates the difference in archived and failed WAL segments, and
+// passes through the last archived/failed WAL segment information as-is
+func (curr PostgresServerArchiverStats) DiffSince(prev PostgresServerArchiverStats) DiffedPostgresServerArchiverStats {
+	if statsResetSince(curr.StatsReset, prev.StatsReset) {
+		prev = PostgresServerArchiverStats{}
+	}
+	return DiffedPostgresServerArchiverStats{
+		ArchivedCount:    curr.ArchivedCount - prev.ArchivedCount,
+		LastArchivedWal:  curr.LastArchivedWal,
+		LastArchivedTime: curr.LastArchivedTime,
+		FailedCount:      curr.FailedCount - prev.FailedCount,
+		LastFailedWal:    curr.LastFailedWal,
+		LastFailedTime:   curr.LastFailedTime,
+		StatsReset:       curr.StatsReset,
+	}
+}
+
+func (curr PostgresServerSlruStats) DiffSince(prev PostgresServerSlruStats) DiffedPostgresServerSlruStats {
+	if statsResetSince(curr.StatsReset, prev.StatsReset) {
+		prev = PostgresServerSlruStats{}
+	}
+	return DiffedPostgresServerSlruStats{
+		BlksZeroed:  curr.BlksZeroed - prev.BlksZeroed,
+		BlksHit:     curr.BlksHit - prev.BlksHit,
+		BlksRead:    curr.BlksRead - prev.BlksRead,
+		BlksWritten: curr.BlksWritten - prev.BlksWritten,
+		BlksExists:  curr.BlksExists - prev.BlksExists,
+		Flushes:     curr.Flushes - prev.Flushes,
+		Truncates:   curr.Truncates - prev.Truncates,
+		StatsReset:  curr.StatsReset,
+	}
+}
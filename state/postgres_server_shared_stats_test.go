@@ -0,0 +1,92 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+)
+
+func TestServerSharedStatsDiffSince(t *testing.T) {
+	reset := null.TimeFrom(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	newReset := null.TimeFrom(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	prev := PostgresServerSharedStats{
+		Wal:          &PostgresServerWalStats{Records: 100, Bytes: 8000, StatsReset: reset},
+		Checkpointer: &PostgresServerCheckpointerStats{NumTimed: 10, BuffersWritten: 500, StatsReset: reset},
+		Bgwriter:     &PostgresServerBgwriterStats{BuffersClean: 20, StatsReset: reset},
+		Archiver:     &PostgresServerArchiverStats{ArchivedCount: 5, FailedCount: 1, StatsReset: reset},
+		Slru: PostgresServerSlruStatsMap{
+			"Xact":      {BlksHit: 1000, StatsReset: reset},
+			"Subtrans":  {BlksHit: 300, StatsReset: reset},
+			"MultiXact": {BlksRead: 7},
+		},
+	}
+	curr := PostgresServerSharedStats{
+		Wal:          &PostgresServerWalStats{Records: 150, Bytes: 12000, StatsReset: reset},
+		Checkpointer: &PostgresServerCheckpointerStats{NumTimed: 2, BuffersWritten: 40, StatsReset: newReset},
+		Bgwriter:     &PostgresServerBgwriterStats{BuffersClean: 25, StatsReset: reset},
+		Archiver: &PostgresServerArchiverStats{
+			ArchivedCount:   6,
+			LastArchivedWal: null.StringFrom("000000010000000000000006"),
+			FailedCount:     1,
+			StatsReset:      reset,
+		},
+		Slru: PostgresServerSlruStatsMap{
+			"Xact":      {BlksHit: 1200, StatsReset: reset},
+			"Subtrans":  {BlksHit: 50, StatsReset: newReset},
+			"MultiXact": {BlksRead: 9, StatsReset: newReset},
+			"Notify":    {BlksZeroed: 3},
+		},
+	}
+
+	diff := curr.DiffSince(prev)
+
+	if diff.Wal == nil || diff.Wal.Records != 50 || diff.Wal.Bytes != 4000 {
+		t.Errorf("Wal: want 50 records, 4000 bytes; got %+v", diff.Wal)
+	}
+	// Reset in between: the current values are the change since the reset
+	if diff.Checkpointer == nil || diff.Checkpointer.NumTimed != 2 || diff.Checkpointer.BuffersWritten != 40 || diff.Checkpointer.StatsReset != newReset {
+		t.Errorf("Checkpointer: want values since reset; got %+v", diff.Checkpointer)
+	}
+	if diff.Bgwriter == nil || diff.Bgwriter.BuffersClean != 5 {
+		t.Errorf("Bgwriter: want 5 buffers cleaned; got %+v", diff.Bgwriter)
+	}
+	if diff.Archiver == nil || diff.Archiver.ArchivedCount != 1 || diff.Archiver.FailedCount != 0 || diff.Archiver.LastArchivedWal.String != "000000010000000000000006" {
+		t.Errorf("Archiver: want 1 archived, 0 failed, last WAL passed through; got %+v", diff.Archiver)
+	}
+
+	wantSlru := map[string]PostgresServerSlruStats{
+		"Xact":      {BlksHit: 200, StatsReset: reset},
+		"Subtrans":  {BlksHit: 50, StatsReset: newReset},
+		"MultiXact": {BlksRead: 9, StatsReset: newReset},
+		"Notify":    {BlksZeroed: 3},
+	}
+	if len(diff.Slru) != len(wantSlru) {
+		t.Errorf("Slru: want %d entries; got %d", len(wantSlru), len(diff.Slru))
+	}
+	for name, want := range wantSlru {
+		if got := diff.Slru[name]; got != want {
+			t.Errorf("Slru %s: want %+v; got %+v", name, want, got)
+		}
+	}
+}
+
+func TestServerSharedStatsDiffSinceFirstRun(t *testing.T) {
+	curr := PostgresServerSharedStats{
+		Wal:      &PostgresServerWalStats{Records: 150},
+		Archiver: &PostgresServerArchiverStats{ArchivedCount: 6},
+	}
+
+	diff := curr.DiffSince(PostgresServerSharedStats{Archiver: &PostgresServerArchiverStats{ArchivedCount: 6}})
+
+	if diff.Wal != nil {
+		t.Errorf("Wal: want nil without previous stats; got %+v", diff.Wal)
+	}
+	if diff.Checkpointer != nil || diff.Bgwriter != nil || diff.Slru != nil {
+		t.Errorf("want nil for stats that were not collected; got %+v", diff)
+	}
+	if diff.Archiver == nil || diff.Archiver.ArchivedCount != 0 {
+		t.Errorf("Archiver: want 0 archived; got %+v", diff.Archiver)
+	}
+}
@@ -0,0 +1,191 @@
+package state
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// ServerMetrics - Metrics exposed on the local metrics endpoint, and used to
+// determine the status reported by the health endpoints (if either is enabled)
+//
+// This contains both the statistics from the latest full snapshot (already
+// converted to metric families when the snapshot is collected), as well as
+// counters about the collector itself, which are updated as the collector runs.
+//
+// All methods are safe to call on a nil receiver, which is the case when
+// neither endpoint is configured.
+type ServerMetrics struct {
+	mutex sync.Mutex
+
+	statistics []util.MetricFamily
+
+	runs map[string]*ServerMetricsRun
+
+	snapshotUploads        int64
+	snapshotUploadFailures int64
+	logLinesProcessed      int64
+	logsReceivedAt         time.Time
+}
+
+// ServerMetricsRun - Statistics about one kind of collector run (e.g. "full")
+type ServerMetricsRun struct {
+	LastDuration  time.Duration
+	LastSuccessAt time.Time
+	LastFailureAt time.Time
+	Successes     int64
+	Failures      int64
+}
+
+func NewServerMetrics() *ServerMetrics {
+	return &ServerMetrics{runs: make(map[string]*ServerMetricsRun)}
+}
+
+// SetStatistics - Replaces the Postgres and system statistics with those of
+// the latest full snapshot
+func (m *ServerMetrics) SetStatistics(families []util.MetricFamily) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.statistics = families
+}
+
+// RecordRun - Records the outcome of a collector run of the given kind
+// (e.g. "full", "activity" or "query_stats")
+func (m *ServerMetrics) RecordRun(kind string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	run, ok := m.runs[kind]
+	if !ok {
+		run = &ServerMetricsRun{}
+		m.runs[kind] = run
+	}
+	run.LastDuration = duration
+	if err != nil {
+		run.LastFailureAt = time.Now()
+		run.Failures++
+	} else {
+		run.LastSuccessAt = time.Now()
+		run.Successes++
+	}
+}
+
+// Run - Returns statistics about the collector runs of the given kind
+func (m *ServerMetrics) Run(kind string) (ServerMetricsRun, bool) {
+	if m == nil {
+		return ServerMetricsRun{}, false
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	run, ok := m.runs[kind]
+	if !ok {
+		return ServerMetricsRun{}, false
+	}
+	return *run, true
+}
+
+func (m *ServerMetrics) RecordSnapshotUpload(err error) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if err != nil {
+		m.snapshotUploadFailures++
+	} else {
+		m.snapshotUploads++
+	}
+}
+
+func (m *ServerMetrics) AddLogLinesProcessed(count int) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.logLinesProcessed += int64(count)
+}
+
+// RecordLogsReceived - Records that log data was received (or downloaded) at the given time
+func (m *ServerMetrics) RecordLogsReceived(at time.Time) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if at.After(m.logsReceivedAt) {
+		m.logsReceivedAt = at
+	}
+}
+
+func (m *ServerMetrics) LogsReceivedAt() time.Time {
+	if m == nil {
+		return time.Time{}
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.logsReceivedAt
+}
+
+// Families - Returns all metric families for this server, including the
+// collector's own metrics
+func (m *ServerMetrics) Families() []util.MetricFamily {
+	if m == nil {
+		return nil
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	runDuration := util.MetricFamily{Name: "pganalyze_collector_run_duration_seconds", Help: "Duration of the last collector run", Type: util.MetricTypeGauge}
+	runLastSuccess := util.MetricFamily{Name: "pganalyze_collector_run_last_success_timestamp_seconds", Help: "Time of the last successful collector run", Type: util.MetricTypeGauge}
+	runSuccesses := util.MetricFamily{Name: "pganalyze_collector_runs_succeeded", Help: "Number of successful collector runs", Type: util.MetricTypeCounter}
+	runFailures := util.MetricFamily{Name: "pganalyze_collector_runs_failed", Help: "Number of failed collector runs", Type: util.MetricTypeCounter}
+	var kinds []string
+	for kind := range m.runs {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		run := m.runs[kind]
+		labels := map[string]string{"kind": kind}
+		runDuration.Samples = append(runDuration.Samples, util.MetricSample{Labels: labels, Value: run.LastDuration.Seconds()})
+		if !run.LastSuccessAt.IsZero() {
+			runLastSuccess.Samples = append(runLastSuccess.Samples, util.MetricSample{Labels: labels, Value: float64(run.LastSuccessAt.UnixNano()) / 1e9})
+		}
+		runSuccesses.Samples = append(runSuccesses.Samples, util.MetricSample{Labels: labels, Value: float64(run.Successes)})
+		runFailures.Samples = append(runFailures.Samples, util.MetricSample{Labels: labels, Value: float64(run.Failures)})
+	}
+
+	families := []util.MetricFamily{
+		runDuration,
+		runLastSuccess,
+		runSuccesses,
+		runFailures,
+		{
+			Name:    "pganalyze_collector_snapshot_uploads",
+			Help:    "Number of snapshots uploaded successfully",
+			Type:    util.MetricTypeCounter,
+			Samples: []util.MetricSample{{Value: float64(m.snapshotUploads)}},
+		},
+		{
+			Name:    "pganalyze_collector_snapshot_upload_failures",
+			Help:    "Number of snapshot uploads that failed",
+			Type:    util.MetricTypeCounter,
+			Samples: []util.MetricSample{{Value: float64(m.snapshotUploadFailures)}},
+		},
+		{
+			Name:    "pganalyze_collector_log_lines_processed",
+			Help:    "Number of log lines processed",
+			Type:    util.MetricTypeCounter,
+			Samples: []util.MetricSample{{Value: float64(m.logLinesProcessed)}},
+		},
+	}
+	return append(families, m.statistics...)
+}
@@ -0,0 +1,245 @@
+package state
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SnapshotSpool - On-disk queue of snapshots that could not be uploaded
+//
+// Each spooled snapshot is stored in its own file (as the compressed data that
+// would have been sent), with the file name encoding the collection time, so
+// that snapshots can be replayed in the order they were collected.
+type SnapshotSpool struct {
+	dir     string
+	maxSize int64
+	maxAge  time.Duration
+	mutex   sync.Mutex
+}
+
+type SpooledSnapshot struct {
+	Filename     string
+	SnapshotUUID string
+	CollectedAt  time.Time
+	Compact      bool
+	Size         int64
+}
+
+const spoolFullSuffix = ".full"
+const spoolCompactSuffix = ".compact"
+const spoolTempSuffix = ".tmp"
+
+var spoolDirSanitizeRegexp = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// Directory name for a server's section, which is sanitized for use in paths
+// and suffixed with a hash of the original name, so that section names that
+// only differ in replaced characters (or in case, on case-insensitive file
+// systems) don't share a directory
+func sectionDirName(sectionName string) string {
+	hash := sha256.Sum256([]byte(sectionName))
+	return spoolDirSanitizeRegexp.ReplaceAllString(sectionName, "_") + "-" + hex.EncodeToString(hash[:4])
+}
+
+func NewSnapshotSpool(baseDir string, sectionName string, maxSize int64, maxAge time.Duration) *SnapshotSpool {
+	return &SnapshotSpool{
+		dir:     filepath.Join(baseDir, sectionDirName(sectionName)),
+		maxSize: maxSize,
+		maxAge:  maxAge,
+	}
+}
+
+func (s *SnapshotSpool) Dir() string {
+	return s.dir
+}
+
+// Add - Stores the snapshot in the spool, discarding the oldest snapshots if
+// the spool would otherwise exceed its maximum size
+//
+// Returns the number of older snapshots that were discarded to make room.
+func (s *SnapshotSpool) Add(data []byte, snapshotUUID string, collectedAt time.Time, compact bool) (int, error) {
+	if int64(len(data)) > s.maxSize {
+		return 0, fmt.Errorf("snapshot size (%d bytes) exceeds maximum spool size (%d bytes)", len(data), s.maxSize)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := os.MkdirAll(s.dir, 0700)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := s.list()
+	if err != nil {
+		return 0, err
+	}
+
+	var totalSize int64
+	for _, entry := range entries {
+		totalSize += entry.Size
+	}
+	discarded := 0
+	for len(entries) > 0 && totalSize+int64(len(data)) > s.maxSize {
+		err = os.Remove(filepath.Join(s.dir, entries[0].Filename))
+		if err != nil && !os.IsNotExist(err) {
+			return discarded, err
+		}
+		totalSize -= entries[0].Size
+		entries = entries[1:]
+		discarded++
+	}
+
+	suffix := spoolFullSuffix
+	if compact {
+		suffix = spoolCompactSuffix
+	}
+	filename := fmt.Sprintf("%020d-%s%s", collectedAt.UnixNano(), snapshotUUID, suffix)
+
+	// Write to a temporary file first, so we never replay a partially written snapshot
+	tmpPath := filepath.Join(s.dir, filename+spoolTempSuffix)
+	err = os.WriteFile(tmpPath, data, 0600)
+	if err != nil {
+		os.Remove(tmpPath)
+		return discarded, err
+	}
+	err = os.Rename(tmpPath, filepath.Join(s.dir, filename))
+	if err != nil {
+		os.Remove(tmpPath)
+		return discarded, err
+	}
+
+	return discarded, nil
+}
+
+// List - Returns all spooled snapshots, oldest first
+//
+// Snapshots that exceed the maximum age are removed from the spool, and their
+// count is returned separately.
+func (s *SnapshotSpool) List() ([]SpooledSnapshot, int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entries, err := s.list()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	expired := 0
+	var valid []SpooledSnapshot
+	cutoff := time.Now().Add(-s.maxAge)
+	for _, entry := range entries {
+		if entry.CollectedAt.Before(cutoff) {
+			err = os.Remove(filepath.Join(s.dir, entry.Filename))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, expired, err
+			}
+			expired++
+			continue
+		}
+		valid = append(valid, entry)
+	}
+
+	return valid, expired, nil
+}
+
+// Stats - Returns the number of spooled snapshots and their total size in bytes
+func (s *SnapshotSpool) Stats() (int, int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entries, err := s.list()
+	if err != nil {
+		return 0, 0, err
+	}
+	var totalSize int64
+	for _, entry := range entries {
+		totalSize += entry.Size
+	}
+	return len(entries), totalSize, nil
+}
+
+func (s *SnapshotSpool) Read(entry SpooledSnapshot) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, entry.Filename))
+}
+
+func (s *SnapshotSpool) Remove(entry SpooledSnapshot) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := os.Remove(filepath.Join(s.dir, entry.Filename))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// list must be called with the mutex held
+func (s *SnapshotSpool) list() ([]SpooledSnapshot, error) {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []SpooledSnapshot
+	for _, dirEntry := range dirEntries {
+		entry, ok := parseSpooledSnapshotFilename(dirEntry.Name())
+		if !ok {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			// File was removed concurrently
+			continue
+		}
+		entry.Size = info.Size()
+		entries = append(entries, entry)
+	}
+
+	// File names start with a zero-padded timestamp, so sorting them sorts by collection time
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Filename < entries[j].Filename
+	})
+
+	return entries, nil
+}
+
+func parseSpooledSnapshotFilename(filename string) (SpooledSnapshot, bool) {
+	var compact bool
+	var name string
+	if strings.HasSuffix(filename, spoolFullSuffix) {
+		name = strings.TrimSuffix(filename, spoolFullSuffix)
+	} else if strings.HasSuffix(filename, spoolCompactSuffix) {
+		name = strings.TrimSuffix(filename, spoolCompactSuffix)
+		compact = true
+	} else {
+		return SpooledSnapshot{}, false
+	}
+
+	timestampStr, snapshotUUID, found := strings.Cut(name, "-")
+	if !found || snapshotUUID == "" {
+		return SpooledSnapshot{}, false
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return SpooledSnapshot{}, false
+	}
+
+	return SpooledSnapshot{
+		Filename:     filename,
+		SnapshotUUID: snapshotUUID,
+		CollectedAt:  time.Unix(0, timestamp),
+		Compact:      compact,
+	}, true
+}
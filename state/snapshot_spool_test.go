@@ -0,0 +1,112 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshotSpoolOrder(t *testing.T) {
+	spool := NewSnapshotSpool(t.TempDir(), "server/1", 1024, time.Hour)
+	now := time.Now()
+
+	spool.Add([]byte("second"), "uuid-2", now.Add(-time.Minute), false)
+	spool.Add([]byte("third"), "uuid-3", now, true)
+	spool.Add([]byte("first"), "uuid-1", now.Add(-2*time.Minute), false)
+
+	entries, expired, err := spool.List()
+	if err != nil {
+		t.Fatalf("List: %s", err)
+	}
+	if expired != 0 {
+		t.Errorf("expired: want 0; got %d", expired)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("entries: want 3; got %d", len(entries))
+	}
+	for idx, expected := range []string{"first", "second", "third"} {
+		data, err := spool.Read(entries[idx])
+		if err != nil {
+			t.Fatalf("Read: %s", err)
+		}
+		if string(data) != expected {
+			t.Errorf("entry %d: want %s; got %s", idx, expected, data)
+		}
+	}
+	if entries[0].SnapshotUUID != "uuid-1" || !entries[0].CollectedAt.Equal(now.Add(-2*time.Minute)) || entries[0].Compact {
+		t.Errorf("entry 0: unexpected metadata %+v", entries[0])
+	}
+	if !entries[2].Compact {
+		t.Errorf("entry 2: want compact; got %+v", entries[2])
+	}
+	if !strings.HasPrefix(filepath.Base(spool.Dir()), "server_1-") {
+		t.Errorf("dir: want server_1-<hash>; got %s", filepath.Base(spool.Dir()))
+	}
+	if other := NewSnapshotSpool(t.TempDir(), "server_1", 1024, time.Hour); filepath.Base(other.Dir()) == filepath.Base(spool.Dir()) {
+		t.Errorf("dir: want different directories for server/1 and server_1; got %s for both", filepath.Base(spool.Dir()))
+	}
+
+	spool.Remove(entries[0])
+	count, size, err := spool.Stats()
+	if err != nil {
+		t.Fatalf("Stats: %s", err)
+	}
+	if count != 2 || size != int64(len("second")+len("third")) {
+		t.Errorf("stats: want 2 / %d; got %d / %d", len("second")+len("third"), count, size)
+	}
+}
+
+func TestSnapshotSpoolMaxSize(t *testing.T) {
+	spool := NewSnapshotSpool(t.TempDir(), "default", 10, time.Hour)
+	now := time.Now()
+
+	for idx, uuid := range []string{"a", "b", "c"} {
+		discarded, err := spool.Add([]byte("1234"), uuid, now.Add(time.Duration(idx)*time.Second), false)
+		if err != nil {
+			t.Fatalf("Add: %s", err)
+		}
+		if idx < 2 && discarded != 0 {
+			t.Errorf("%s: want 0 discarded; got %d", uuid, discarded)
+		}
+		if idx == 2 && discarded != 1 {
+			t.Errorf("%s: want 1 discarded; got %d", uuid, discarded)
+		}
+	}
+
+	entries, _, _ := spool.List()
+	if len(entries) != 2 || entries[0].SnapshotUUID != "b" || entries[1].SnapshotUUID != "c" {
+		t.Errorf("want entries b, c; got %+v", entries)
+	}
+
+	_, err := spool.Add([]byte("12345678901"), "d", now, false)
+	if err == nil {
+		t.Errorf("want error for snapshot exceeding spool size; got nil")
+	}
+}
+
+func TestSnapshotSpoolMaxAge(t *testing.T) {
+	spool := NewSnapshotSpool(t.TempDir(), "default", 1024, time.Hour)
+	now := time.Now()
+
+	spool.Add([]byte("old"), "old", now.Add(-2*time.Hour), false)
+	spool.Add([]byte("new"), "new", now, false)
+	// Leftovers from an interrupted write must be ignored
+	os.WriteFile(filepath.Join(spool.Dir(), "00000000000000000001-partial.full.tmp"), []byte("partial"), 0600)
+
+	entries, expired, err := spool.List()
+	if err != nil {
+		t.Fatalf("List: %s", err)
+	}
+	if expired != 1 {
+		t.Errorf("expired: want 1; got %d", expired)
+	}
+	if len(entries) != 1 || entries[0].SnapshotUUID != "new" {
+		t.Errorf("want entry new; got %+v", entries)
+	}
+	count, _, _ := spool.Stats()
+	if count != 1 {
+		t.Errorf("count: want 1; got %d", count)
+	}
+}
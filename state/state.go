@@ -11,6 +11,7 @@ import (
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/secrets"
 )
 
 type SchemaStats struct {
@@ -41,6 +42,8 @@ type PersistedHighFreqState struct {
 	PlanStats      PostgresPlanStatsMap
 	ServerIoStats  PostgresServerIoStatsMap
 
+	ServerSharedStats PostgresServerSharedStats
+
 	// Keep track of when we last collected statement stats, to calculate time distance
 	LastStatementStatsAt time.Time
 
@@ -51,7 +54,8 @@ type PersistedHighFreqState struct {
 	UnidentifiedPlanStats HistoricPlanStatsMap
 
 	// Data queued up for submission with the next full snapshot
-	QueuedServerIoStats HistoricPostgresServerIoStatsMap
+	QueuedServerIoStats     HistoricPostgresServerIoStatsMap
+	QueuedServerSharedStats HistoricPostgresServerSharedStatsMap
 }
 
 // TransientState - State thats only used within a collector run (and not needed for diffs)
@@ -70,6 +74,8 @@ type TransientState struct {
 	Plans          PostgresPlanMap
 	ServerIoStats  HistoricPostgresServerIoStatsMap
 
+	ServerSharedStats HistoricPostgresServerSharedStatsMap
+
 	// This is a new zero value that was recorded after a pg_stat_statements_reset(),
 	// in order to enable the next snapshot to be able to diff against something
 	ResetStatementStats PostgresStatementStatsMap
@@ -231,6 +237,8 @@ type CollectionOpts struct {
 	GenerateStatsHelperSql           string
 	GenerateExplainAnalyzeHelperSql  string
 	GenerateExplainAnalyzeHelperRole string
+	GenerateMaintenanceHelperSql     string
+	GenerateMaintenanceHelperRole    string
 	DebugLogs                        bool
 	DiscoverLogLocation              bool
 
@@ -281,6 +289,26 @@ type QueryRun struct {
 	StartedAt           time.Time
 	FinishedAt          time.Time
 	BackendPid          int
+	Progress            *QueryRunProgress
+
+	// Set when the pganalyze server requested cancellation, and when the query
+	// run was subsequently stopped because of that
+	CancelRequested bool
+	Cancelled       bool
+}
+
+// QueryRunProgress - Progress of a CREATE INDEX or REINDEX query run, as
+// reported by pg_stat_progress_create_index
+type QueryRunProgress struct {
+	Phase           string
+	BlocksTotal     int64
+	BlocksDone      int64
+	TuplesTotal     int64
+	TuplesDone      int64
+	LockersTotal    int64
+	LockersDone     int64
+	PartitionsTotal int64
+	PartitionsDone  int64
 }
 
 type Server struct {
@@ -288,6 +316,11 @@ type Server struct {
 	RequestedSslMode string
 	Grant            atomic.Pointer[Grant]
 
+	// Whether the server rejected SSL connections with sslmode=prefer, so that
+	// connections fall back to sslmode=disable (connections for different
+	// databases are established concurrently, so this must not live in Config)
+	SslModePreferFailed atomic.Bool
+
 	PrevState  PersistedState
 	StateMutex *sync.Mutex
 
@@ -311,6 +344,21 @@ type Server struct {
 	InitialConfigReceived chan struct{}
 	Pause                 atomic.Bool
 
+	// On-disk spool for snapshots that failed to upload (nil if not configured)
+	SnapshotSpool *SnapshotSpool
+
+	// Local archive of analyzed log lines (nil if not configured)
+	LogArchive *LogArchive
+
+	// Metrics served on the local metrics and health endpoints (nil if neither is configured)
+	Metrics *ServerMetrics
+
+	// Database connections kept open for reuse (nil if disabled, or for test runs)
+	ConnectionPool *ConnectionPool
+
+	// Provider for database credentials (nil if credentials come from the config)
+	SecretProvider secrets.Provider
+
 	// State to track queries the collector is running on behalf of a user
 	QueryRuns      map[int64]*QueryRun
 	QueryRunsMutex *sync.Mutex
@@ -330,6 +378,9 @@ type Server struct {
 
 	// Cache of Postgres query_id -> pg_query fingerprint mappings
 	Fingerprints *Fingerprints
+
+	// Per-query statistics derived from log_statement/log_duration output (nil if not enabled)
+	LogQueryStats *LogQueryStats
 }
 
 func MakeServer(config config.ServerConfig, testRun bool) *Server {
@@ -350,6 +401,22 @@ func MakeServer(config config.ServerConfig, testRun bool) *Server {
 	}
 	server.Grant.Store(&Grant{Config: pganalyze_collector.ServerMessage_Config{Features: &pganalyze_collector.ServerMessage_Features{}}})
 	server.Pause.Store(false)
+	if config.SnapshotSpoolDir != "" {
+		server.SnapshotSpool = NewSnapshotSpool(config.SnapshotSpoolDir, config.SectionName, int64(config.SnapshotSpoolMaxSizeMB)*1024*1024, config.SnapshotSpoolMaxAgeParsed)
+	}
+	if config.LogArchiveDir != "" && !testRun {
+		server.LogArchive = NewLogArchive(config.LogArchiveDir, config.SectionName, int64(config.LogArchiveMaxFileSizeMB)*1024*1024, config.LogArchiveRotateIntervalParsed, config.LogArchiveMaxFiles)
+	}
+	if config.MetricsListenAddress != "" || config.HealthListenAddress != "" {
+		server.Metrics = NewServerMetrics()
+	}
+	if !testRun && config.DbConnectionIdleTimeoutParsed > 0 {
+		server.ConnectionPool = NewConnectionPool(config.MaxCollectorConnections, config.DbConnectionIdleTimeoutParsed)
+	}
+	if config.EnableLogQueryStats {
+		server.LogQueryStats = NewLogQueryStats(config.FilterQueryText)
+	}
+	server.SecretProvider = secrets.NewProvider(config)
 	if testRun {
 		server.SelfTest = MakeSelfTest()
 	}
@@ -394,6 +461,9 @@ func (s *Server) GetLogParser() LogParser {
 // high log volume due to running with log_statement=all or log_duration=on
 // (something we can't parse effectively with today's regexp-based log parsing),
 // and allow other less frequent log events to be analyzed.
+//
+// With enable_log_query_stats, callers pass these lines to LogQueryStats first,
+// so they still count towards the log-derived query statistics.
 func (s *Server) IgnoreLogLine(content string) bool {
 	flags := atomic.LoadUint32(&s.LogIgnoreFlags)
 
@@ -0,0 +1,37 @@
+CREATE OR REPLACE FUNCTION pganalyze.maintenance_role() RETURNS name AS $$
+BEGIN
+  PERFORM 1 FROM pg_roles WHERE (rolname = current_user AND rolsuper) OR (pg_has_role(oid, 'MEMBER') AND rolname IN ('rds_superuser', 'azure_pg_admin', 'cloudsqlsuperuser'));
+  IF FOUND THEN
+    RAISE EXCEPTION 'cannot run: pganalyze.maintenance_role helper is owned by superuser - recreate function with lesser privileged user';
+  END IF;
+
+  IF pg_catalog.pg_is_in_recovery() THEN
+    RAISE EXCEPTION 'cannot run maintenance commands on a replica';
+  END IF;
+
+  RETURN current_user;
+END
+$$ LANGUAGE plpgsql VOLATILE SECURITY DEFINER SET search_path = pg_catalog, pg_temp;
+
+CREATE OR REPLACE FUNCTION pganalyze.analyze_table(relation regclass, columns text[]) RETURNS void AS $$
+DECLARE
+  column_list text := '';
+BEGIN
+  PERFORM 1 FROM pg_roles WHERE (rolname = current_user AND rolsuper) OR (pg_has_role(oid, 'MEMBER') AND rolname IN ('rds_superuser', 'azure_pg_admin', 'cloudsqlsuperuser'));
+  IF FOUND THEN
+    RAISE EXCEPTION 'cannot run: pganalyze.analyze_table helper is owned by superuser - recreate function with lesser privileged user';
+  END IF;
+
+  IF pg_catalog.pg_is_in_recovery() THEN
+    RAISE EXCEPTION 'cannot run maintenance commands on a replica';
+  END IF;
+
+  IF cardinality(columns) > 0 THEN
+    SELECT ' (' || pg_catalog.string_agg(pg_catalog.quote_ident(c), ', ') || ')'
+      FROM pg_catalog.unnest(columns) _(c) INTO column_list;
+  END IF;
+
+  -- The relation name is resolved by the caller, and schema-qualified here due to the search_path
+  EXECUTE 'ANALYZE ' || relation::text || column_list;
+END
+$$ LANGUAGE plpgsql VOLATILE SECURITY DEFINER SET search_path = pg_catalog, pg_temp;
@@ -0,0 +1,124 @@
+package util
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type MetricType string
+
+const (
+	MetricTypeGauge   MetricType = "gauge"
+	MetricTypeCounter MetricType = "counter"
+)
+
+// MetricFamily - A set of samples that share the same metric name, in the
+// OpenMetrics text format sense
+//
+// For counters, Name must not include the "_total" suffix, it gets added
+// when writing out the samples.
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []MetricSample
+}
+
+type MetricSample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+func (f *MetricFamily) Add(value float64, labels map[string]string) {
+	f.Samples = append(f.Samples, MetricSample{Labels: labels, Value: value})
+}
+
+// WriteOpenMetrics - Writes the metric families in the OpenMetrics text format
+//
+// Families with the same name are merged (e.g. when combining metrics from
+// multiple servers), with the metadata of the first occurrence being used.
+func WriteOpenMetrics(w io.Writer, families []MetricFamily) error {
+	var names []string
+	merged := make(map[string]*MetricFamily)
+	for _, family := range families {
+		existing, ok := merged[family.Name]
+		if !ok {
+			f := family
+			f.Samples = append([]MetricSample{}, family.Samples...)
+			merged[family.Name] = &f
+			names = append(names, family.Name)
+			continue
+		}
+		existing.Samples = append(existing.Samples, family.Samples...)
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		family := merged[name]
+		bw.WriteString("# TYPE " + family.Name + " " + string(family.Type) + "\n")
+		if family.Help != "" {
+			bw.WriteString("# HELP " + family.Name + " " + escapeMetricHelp(family.Help) + "\n")
+		}
+		sampleName := family.Name
+		if family.Type == MetricTypeCounter {
+			sampleName += "_total"
+		}
+		for _, sample := range family.Samples {
+			bw.WriteString(sampleName)
+			writeMetricLabels(bw, sample.Labels)
+			bw.WriteString(" " + formatMetricValue(sample.Value) + "\n")
+		}
+	}
+	bw.WriteString("# EOF\n")
+
+	return bw.Flush()
+}
+
+func writeMetricLabels(bw *bufio.Writer, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	// Sort label names so the output is stable between scrapes
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	bw.WriteString("{")
+	for idx, key := range keys {
+		if idx > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString(key + "=\"" + escapeMetricLabelValue(labels[key]) + "\"")
+	}
+	bw.WriteString("}")
+}
+
+var metricLabelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+var metricHelpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeMetricLabelValue(value string) string {
+	return metricLabelValueReplacer.Replace(value)
+}
+
+func escapeMetricHelp(help string) string {
+	return metricHelpReplacer.Replace(help)
+}
+
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
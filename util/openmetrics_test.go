@@ -0,0 +1,75 @@
+package util_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/pganalyze/collector/util"
+)
+
+var writeOpenMetricsTests = []struct {
+	families []util.MetricFamily
+	expected string
+}{
+	{
+		nil,
+		"# EOF\n",
+	},
+	{
+		[]util.MetricFamily{
+			{
+				Name: "pganalyze_database_size_bytes",
+				Help: "Size of the database",
+				Type: util.MetricTypeGauge,
+				Samples: []util.MetricSample{
+					{Labels: map[string]string{"server": "default", "database": "postgres"}, Value: 8192},
+					{Labels: map[string]string{"server": "default", "database": "we\"ird\\db\n"}, Value: 0.5},
+				},
+			},
+		},
+		"# TYPE pganalyze_database_size_bytes gauge\n" +
+			"# HELP pganalyze_database_size_bytes Size of the database\n" +
+			"pganalyze_database_size_bytes{database=\"postgres\",server=\"default\"} 8192\n" +
+			"pganalyze_database_size_bytes{database=\"we\\\"ird\\\\db\\n\",server=\"default\"} 0.5\n" +
+			"# EOF\n",
+	},
+	{
+		[]util.MetricFamily{
+			{
+				Name:    "pganalyze_collector_runs",
+				Type:    util.MetricTypeCounter,
+				Samples: []util.MetricSample{{Labels: map[string]string{"server": "a"}, Value: 3}},
+			},
+			{
+				Name:    "pganalyze_collector_up",
+				Type:    util.MetricTypeGauge,
+				Samples: []util.MetricSample{{Value: math.NaN()}},
+			},
+			{
+				Name:    "pganalyze_collector_runs",
+				Type:    util.MetricTypeCounter,
+				Samples: []util.MetricSample{{Labels: map[string]string{"server": "b"}, Value: 1}},
+			},
+		},
+		"# TYPE pganalyze_collector_runs counter\n" +
+			"pganalyze_collector_runs_total{server=\"a\"} 3\n" +
+			"pganalyze_collector_runs_total{server=\"b\"} 1\n" +
+			"# TYPE pganalyze_collector_up gauge\n" +
+			"pganalyze_collector_up NaN\n" +
+			"# EOF\n",
+	},
+}
+
+func TestWriteOpenMetrics(t *testing.T) {
+	for _, test := range writeOpenMetricsTests {
+		var buf bytes.Buffer
+		err := util.WriteOpenMetrics(&buf, test.families)
+		if err != nil {
+			t.Errorf("WriteOpenMetrics: %s", err)
+		}
+		if buf.String() != test.expected {
+			t.Errorf("WriteOpenMetrics:\nwant %q\ngot  %q", test.expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// Credentials stored as a JSON secret in AWS Secrets Manager (as used for RDS
+// managed master user passwords, and by the RDS secret rotation functions)
+//
+// This calls the GetSecretValue API directly, since only this single API call
+// is needed, see https://docs.aws.amazon.com/secretsmanager/latest/apireference/API_GetSecretValue.html
+type awsSecretsManagerSource struct {
+	cfg             config.ServerConfig
+	httpClient      *http.Client
+	refreshInterval time.Duration
+}
+
+func (s *awsSecretsManagerSource) fetch(ctx context.Context, now time.Time) (Credentials, error) {
+	awsCfg, err := awsutil.GetAwsConfig(ctx, s.cfg)
+	if err != nil {
+		return Credentials{}, err
+	}
+	awsCreds, err := awsCfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to retrieve AWS credentials: %s", err)
+	}
+
+	region := s.region(awsCfg.Region)
+	if region == "" {
+		return Credentials{}, fmt.Errorf("AWS region is not set, specify aws_region or use a secret ARN")
+	}
+	endpoint := s.cfg.AwsEndpointSecretsManagerURL
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", region)
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": s.cfg.AwsSecretsManagerSecretID})
+	if err != nil {
+		return Credentials{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Credentials{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	payloadHash := sha256.Sum256(body)
+	err = v4.NewSigner().SignHTTP(ctx, awsCreds, req, hex.EncodeToString(payloadHash[:]), "secretsmanager", region, now)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return Credentials{}, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var result struct {
+		SecretString string `json:"SecretString"`
+		Type         string `json:"__type"`
+		Message      string `json:"message"`
+		MessageAlt   string `json:"Message"`
+	}
+	err = json.Unmarshal(respBody, &result)
+	if resp.StatusCode != http.StatusOK {
+		message := result.Message
+		if message == "" {
+			message = result.MessageAlt
+		}
+		return Credentials{}, fmt.Errorf("AWS Secrets Manager returned HTTP %d: %s %s", resp.StatusCode, result.Type, message)
+	}
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to decode AWS Secrets Manager response: %s", err)
+	}
+	if result.SecretString == "" {
+		return Credentials{}, fmt.Errorf("secret has no string value (binary secrets are not supported)")
+	}
+
+	var data map[string]any
+	err = json.Unmarshal([]byte(result.SecretString), &data)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("secret value is not a JSON object: %s", err)
+	}
+	creds, err := credentialsFromSecret(data)
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds.refreshAt = now.Add(s.refreshInterval)
+	return creds, nil
+}
+
+// Determines the region to sign requests for, preferring the region of the
+// secret ARN (arn:aws:secretsmanager:<region>:<account>:secret:<name>) since
+// secrets may be stored in a different region than the database
+func (s *awsSecretsManagerSource) region(defaultRegion string) string {
+	if s.cfg.AwsEndpointSecretsManagerURL != "" && s.cfg.AwsEndpointSigningRegion != "" {
+		return s.cfg.AwsEndpointSigningRegion
+	}
+	parts := strings.SplitN(s.cfg.AwsSecretsManagerSecretID, ":", 6)
+	if len(parts) == 6 && parts[0] == "arn" && parts[3] != "" {
+		return parts[3]
+	}
+	if s.cfg.AwsRegion != "" {
+		return s.cfg.AwsRegion
+	}
+	return defaultRegion
+}
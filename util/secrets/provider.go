@@ -0,0 +1,241 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Credentials - Database username and password returned by a secret provider
+type Credentials struct {
+	Username string
+	Password string
+
+	// When the credentials should be read again (or their lease renewed)
+	refreshAt time.Time
+
+	// Lease information for dynamic credentials (empty for static secrets)
+	leaseID       string
+	leaseDuration time.Duration
+	leaseExpiry   time.Time
+	renewable     bool
+
+	// Lease duration when the credentials were issued, which renewals request
+	// again (leaseDuration shrinks once renewals get capped by the maximum TTL)
+	issuedLeaseDuration time.Duration
+}
+
+// Provider - Returns credentials for new database connections
+//
+// Credentials are cached until they need to be refreshed, so this can be called
+// for every new connection. Providers are safe for concurrent use.
+type Provider interface {
+	// Credentials - Returns the current credentials, reading them from the secret
+	// store (or renewing their lease) when needed
+	Credentials(ctx context.Context) (Credentials, error)
+
+	// Invalidate - Discards the cached credentials, so they are read again on the
+	// next call (e.g. after an authentication failure due to rotated credentials)
+	Invalidate()
+
+	// Refresh - Reads the credentials again (or renews their lease) once due,
+	// independent of new connections being opened, so leases don't expire while
+	// existing connections use them
+	//
+	// While credentials replaced at replacedAt have leases that were not revoked
+	// yet, closeReplaced is called on every refresh to close connections using
+	// them, and returns whether any of those connections are still in use. The
+	// leases are only revoked once no connection uses them anymore.
+	Refresh(ctx context.Context, closeReplaced func(replacedAt time.Time) (inUse bool)) error
+}
+
+// ProviderError - Failure to retrieve credentials from a secret provider
+type ProviderError struct {
+	Provider string
+	Err      error
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("failed to retrieve database credentials from %s secret provider: %s", e.Provider, e.Err)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// Source of credentials, called by the cached provider when credentials need to
+// be read again
+type source interface {
+	fetch(ctx context.Context, now time.Time) (Credentials, error)
+}
+
+// Source that supports extending the lease of previously returned credentials
+type renewableSource interface {
+	source
+	renew(ctx context.Context, creds Credentials, now time.Time) (Credentials, error)
+}
+
+// Source that supports revoking the lease of credentials that were replaced
+type revocableSource interface {
+	source
+	revoke(ctx context.Context, creds Credentials) error
+}
+
+// Timeout for requests to the secret store
+const requestTimeout = 30 * time.Second
+
+// NewProvider - Creates the secret provider for the server's database credentials
+//
+// Returns nil if no secret provider is configured. The settings are validated
+// when reading the config, so unknown providers are ignored here.
+func NewProvider(cfg config.ServerConfig) Provider {
+	var src source
+	switch cfg.DbSecretProvider {
+	case "vault_kv":
+		src = &vaultKVSource{
+			client:          newVaultClient(cfg),
+			mount:           cfg.VaultKvMount,
+			path:            cfg.VaultSecretPath,
+			refreshInterval: cfg.DbSecretRefreshIntervalParsed,
+		}
+	case "vault_database":
+		src = &vaultDatabaseSource{
+			client: newVaultClient(cfg),
+			mount:  cfg.VaultDatabaseMount,
+			role:   cfg.VaultDatabaseRole,
+		}
+	case "aws_secrets_manager":
+		src = &awsSecretsManagerSource{
+			cfg:             cfg,
+			httpClient:      newHTTPClient(cfg),
+			refreshInterval: cfg.DbSecretRefreshIntervalParsed,
+		}
+	default:
+		return nil
+	}
+	return &cachedProvider{name: cfg.DbSecretProvider, source: src, now: time.Now}
+}
+
+type cachedProvider struct {
+	name   string
+	source source
+	now    func() time.Time
+
+	mutex sync.Mutex
+	creds *Credentials
+
+	// Credentials that were replaced, whose lease has not been revoked yet
+	replaced   []Credentials
+	replacedAt time.Time
+}
+
+func (p *cachedProvider) Credentials(ctx context.Context) (Credentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	if p.creds != nil && now.Before(p.creds.refreshAt) {
+		return *p.creds, nil
+	}
+
+	// Extend the lease of dynamic credentials while possible, so existing
+	// connections keep working. Once the lease can't be extended meaningfully
+	// anymore (e.g. because it reached its maximum TTL) new credentials are
+	// requested instead.
+	if p.creds != nil && p.creds.renewable && now.Before(p.creds.leaseExpiry) {
+		if src, ok := p.source.(renewableSource); ok {
+			renewed, err := src.renew(ctx, *p.creds, now)
+			if err == nil && renewed.leaseDuration >= p.creds.issuedLeaseDuration/3 {
+				p.creds = &renewed
+				return renewed, nil
+			}
+		}
+	}
+
+	creds, err := p.source.fetch(ctx, now)
+	if err != nil {
+		return Credentials{}, &ProviderError{Provider: p.name, Err: err}
+	}
+	if p.creds != nil && (p.creds.Username != creds.Username || p.creds.Password != creds.Password || p.creds.leaseID != creds.leaseID) {
+		p.replaced = append(p.replaced, *p.creds)
+		p.replacedAt = now
+	}
+	p.creds = &creds
+	return creds, nil
+}
+
+func (p *cachedProvider) Invalidate() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.creds != nil {
+		// Keep the credentials around (instead of discarding them), so that their
+		// lease can be revoked once they are replaced
+		p.creds.refreshAt = time.Time{}
+		p.creds.renewable = false
+	}
+}
+
+func (p *cachedProvider) Refresh(ctx context.Context, closeReplaced func(replacedAt time.Time) (inUse bool)) error {
+	_, err := p.Credentials(ctx)
+
+	p.mutex.Lock()
+	replaced := p.replaced
+	replacedAt := p.replacedAt
+	p.mutex.Unlock()
+
+	if len(replaced) == 0 {
+		return err
+	}
+	if closeReplaced(replacedAt) {
+		// Revoking the lease would terminate connections that are still in use,
+		// try again on the next refresh
+		return err
+	}
+
+	p.mutex.Lock()
+	p.replaced = p.replaced[len(replaced):]
+	now := p.now()
+	p.mutex.Unlock()
+
+	src, ok := p.source.(revocableSource)
+	if !ok {
+		return err
+	}
+	for _, creds := range replaced {
+		if creds.leaseID == "" || !now.Before(creds.leaseExpiry) {
+			continue
+		}
+		revokeErr := src.revoke(ctx, creds)
+		if revokeErr != nil && err == nil {
+			err = &ProviderError{Provider: p.name, Err: fmt.Errorf("failed to revoke lease of replaced credentials: %s", revokeErr)}
+		}
+	}
+	return err
+}
+
+// newHTTPClient - HTTP client for requests to the secret store
+//
+// This intentionally doesn't use the client for pganalyze API requests, since
+// secret stores commonly run on internal addresses and non-standard ports.
+func newHTTPClient(cfg config.ServerConfig) *http.Client {
+	proxyConfig := httpproxy.Config{
+		HTTPProxy:  cfg.HTTPProxy,
+		HTTPSProxy: cfg.HTTPSProxy,
+		NoProxy:    cfg.NoProxy,
+	}
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			Proxy: func(req *http.Request) (*url.URL, error) {
+				return proxyConfig.ProxyFunc()(req.URL)
+			},
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
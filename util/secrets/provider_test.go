@@ -0,0 +1,351 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestProvider(t *testing.T, cfg config.ServerConfig) (*cachedProvider, *fakeClock) {
+	provider, ok := NewProvider(cfg).(*cachedProvider)
+	if !ok {
+		t.Fatalf("want cached provider for %q", cfg.DbSecretProvider)
+	}
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	provider.now = clock.Now
+	return provider, clock
+}
+
+func expectCredentials(t *testing.T, provider Provider, username string, password string) {
+	t.Helper()
+	creds, err := provider.Credentials(context.Background())
+	if err != nil {
+		t.Fatalf("want nil; got %v", err)
+	}
+	if creds.Username != username || creds.Password != password {
+		t.Errorf("want %s/%s; got %s/%s", username, password, creds.Username, creds.Password)
+	}
+}
+
+func TestNewProviderNone(t *testing.T) {
+	if provider := NewProvider(config.ServerConfig{}); provider != nil {
+		t.Errorf("want nil; got %v", provider)
+	}
+}
+
+func TestVaultKV(t *testing.T) {
+	var requests atomic.Int32
+	var password atomic.Value
+	password.Store("first")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/kv/data/pganalyze/db" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"data":{"username":"pganalyze","password":"` + password.Load().(string) + `"},"metadata":{"version":1}}}`))
+	}))
+	defer server.Close()
+
+	provider, clock := newTestProvider(t, config.ServerConfig{
+		DbSecretProvider:              "vault_kv",
+		DbSecretRefreshIntervalParsed: 5 * time.Minute,
+		VaultAddr:                     server.URL + "/",
+		VaultToken:                    "test-token",
+		VaultKvMount:                  "kv",
+		VaultSecretPath:               "/pganalyze/db",
+	})
+
+	expectCredentials(t, provider, "pganalyze", "first")
+
+	// Cached until the refresh interval has passed
+	password.Store("second")
+	clock.now = clock.now.Add(4 * time.Minute)
+	expectCredentials(t, provider, "pganalyze", "first")
+	if requests.Load() != 1 {
+		t.Errorf("want 1 request; got %d", requests.Load())
+	}
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	expectCredentials(t, provider, "pganalyze", "second")
+
+	// Invalidation (e.g. after an authentication failure) reads the secret again
+	password.Store("third")
+	provider.Invalidate()
+	expectCredentials(t, provider, "pganalyze", "third")
+	if requests.Load() != 3 {
+		t.Errorf("want 3 requests; got %d", requests.Load())
+	}
+}
+
+func TestVaultKVError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":["permission denied"]}`))
+	}))
+	defer server.Close()
+
+	provider, _ := newTestProvider(t, config.ServerConfig{
+		DbSecretProvider: "vault_kv",
+		VaultAddr:        server.URL,
+		VaultKvMount:     "secret",
+		VaultSecretPath:  "pganalyze",
+	})
+
+	_, err := provider.Credentials(context.Background())
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) || providerErr.Provider != "vault_kv" {
+		t.Fatalf("want ProviderError; got %v", err)
+	}
+	expected := "failed to retrieve database credentials from vault_kv secret provider: Vault returned HTTP 403 for secret/data/pganalyze: permission denied"
+	if err.Error() != expected {
+		t.Errorf("want %q; got %q", expected, err.Error())
+	}
+}
+
+func TestVaultDatabase(t *testing.T) {
+	var issued, renewals atomic.Int32
+	var renewDuration, renewIncrement atomic.Int32
+	renewDuration.Store(300)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/database/creds/readonly":
+			n := issued.Add(1)
+			w.Write([]byte(`{"lease_id":"database/creds/readonly/lease` + string(rune('0'+n)) + `","lease_duration":300,"renewable":true,` +
+				`"data":{"username":"v-token-readonly-` + string(rune('0'+n)) + `","password":"secret"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/renew":
+			renewals.Add(1)
+			body, _ := io.ReadAll(r.Body)
+			var req struct {
+				LeaseID   string `json:"lease_id"`
+				Increment int    `json:"increment"`
+			}
+			json.Unmarshal(body, &req)
+			renewIncrement.Store(int32(req.Increment))
+			if !strings.HasPrefix(req.LeaseID, "database/creds/readonly/lease") {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"errors":["invalid request"]}`))
+				return
+			}
+			resp, _ := json.Marshal(map[string]any{"lease_id": req.LeaseID, "lease_duration": renewDuration.Load(), "renewable": true})
+			w.Write(resp)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	provider, clock := newTestProvider(t, config.ServerConfig{
+		DbSecretProvider:   "vault_database",
+		VaultAddr:          server.URL,
+		VaultDatabaseMount: "database",
+		VaultDatabaseRole:  "readonly",
+	})
+
+	expectCredentials(t, provider, "v-token-readonly-1", "secret")
+
+	// The lease gets renewed after two thirds of its duration
+	clock.now = clock.now.Add(time.Minute)
+	expectCredentials(t, provider, "v-token-readonly-1", "secret")
+	clock.now = clock.now.Add(3 * time.Minute)
+	expectCredentials(t, provider, "v-token-readonly-1", "secret")
+	if issued.Load() != 1 || renewals.Load() != 1 {
+		t.Errorf("want 1 issued and 1 renewal; got %d issued and %d renewals", issued.Load(), renewals.Load())
+	}
+
+	// Once the lease reaches its maximum TTL, new credentials are requested
+	renewDuration.Store(20)
+	clock.now = clock.now.Add(4 * time.Minute)
+	expectCredentials(t, provider, "v-token-readonly-2", "secret")
+	if issued.Load() != 2 || renewals.Load() != 2 {
+		t.Errorf("want 2 issued and 2 renewals; got %d issued and %d renewals", issued.Load(), renewals.Load())
+	}
+
+	// Renewals shrinking towards the maximum TTL are compared against the
+	// lease duration the credentials were issued with, not the previous renewal
+	renewDuration.Store(150)
+	clock.now = clock.now.Add(4 * time.Minute)
+	expectCredentials(t, provider, "v-token-readonly-2", "secret")
+	renewDuration.Store(60)
+	clock.now = clock.now.Add(2 * time.Minute)
+	expectCredentials(t, provider, "v-token-readonly-3", "secret")
+	if issued.Load() != 3 || renewals.Load() != 4 {
+		t.Errorf("want 3 issued and 4 renewals; got %d issued and %d renewals", issued.Load(), renewals.Load())
+	}
+	if renewIncrement.Load() != 300 {
+		t.Errorf("want renewal to request the issued lease duration of 300s; got %ds", renewIncrement.Load())
+	}
+
+	// Expired leases are not renewed
+	clock.now = clock.now.Add(time.Hour)
+	expectCredentials(t, provider, "v-token-readonly-4", "secret")
+	if renewals.Load() != 4 {
+		t.Errorf("want 4 renewals; got %d", renewals.Load())
+	}
+}
+
+func TestVaultDatabaseRefresh(t *testing.T) {
+	var issued atomic.Int32
+	var revoked atomic.Value
+	revoked.Store("")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/database/creds/readonly":
+			n := issued.Add(1)
+			w.Write([]byte(`{"lease_id":"database/creds/readonly/lease` + string(rune('0'+n)) + `","lease_duration":300,"renewable":true,` +
+				`"data":{"username":"v-token-readonly-` + string(rune('0'+n)) + `","password":"secret"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/renew":
+			// The lease reached its maximum TTL
+			w.Write([]byte(`{"lease_id":"database/creds/readonly/lease1","lease_duration":20,"renewable":true}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/revoke":
+			var req struct {
+				LeaseID string `json:"lease_id"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			revoked.Store(revoked.Load().(string) + req.LeaseID + ";")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	provider, clock := newTestProvider(t, config.ServerConfig{
+		DbSecretProvider:   "vault_database",
+		VaultAddr:          server.URL,
+		VaultDatabaseMount: "database",
+		VaultDatabaseRole:  "readonly",
+	})
+
+	replacements := 0
+	connectionInUse := false
+	refresh := func() {
+		t.Helper()
+		err := provider.Refresh(context.Background(), func(replacedAt time.Time) bool {
+			if !replacedAt.Equal(clock.now) {
+				t.Errorf("want replaced at %v; got %v", clock.now, replacedAt)
+			}
+			replacements++
+			return connectionInUse
+		})
+		if err != nil {
+			t.Fatalf("want nil; got %v", err)
+		}
+	}
+
+	refresh()
+	clock.now = clock.now.Add(time.Minute)
+	refresh()
+	if issued.Load() != 1 || replacements != 0 {
+		t.Errorf("want 1 issued and no replacements; got %d issued and %d replacements", issued.Load(), replacements)
+	}
+
+	// Once the lease can't be renewed anymore, the credentials are replaced
+	// without a new connection being opened, and the old lease is revoked
+	clock.now = clock.now.Add(3 * time.Minute)
+	refresh()
+	if issued.Load() != 2 || replacements != 1 {
+		t.Errorf("want 2 issued and 1 replacement; got %d issued and %d replacements", issued.Load(), replacements)
+	}
+	if revoked.Load() != "database/creds/readonly/lease1;" {
+		t.Errorf("want first lease to be revoked; got %q", revoked.Load())
+	}
+	expectCredentials(t, provider, "v-token-readonly-2", "secret")
+
+	// Credentials replaced after an authentication failure are handled the same
+	// way, but their lease is only revoked once no connection uses them anymore
+	provider.Invalidate()
+	expectCredentials(t, provider, "v-token-readonly-3", "secret")
+	connectionInUse = true
+	refresh()
+	if replacements != 2 || revoked.Load() != "database/creds/readonly/lease1;" {
+		t.Errorf("want 2 replacements and second lease not yet revoked; got %d replacements, revoked %q", replacements, revoked.Load())
+	}
+	connectionInUse = false
+	refresh()
+	if replacements != 3 || revoked.Load() != "database/creds/readonly/lease1;database/creds/readonly/lease2;" {
+		t.Errorf("want 3 replacements and second lease to be revoked; got %d replacements, revoked %q", replacements, revoked.Load())
+	}
+	refresh()
+	if replacements != 3 {
+		t.Errorf("want no further replacements; got %d", replacements)
+	}
+}
+
+func TestAwsSecretsManager(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") || !strings.Contains(auth, "/eu-west-1/secretsmanager/aws4_request") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"__type":"UnrecognizedClientException","message":"invalid signature"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" || !strings.Contains(string(body), `"SecretId":"arn:aws:secretsmanager:eu-west-1:123456789012:secret:pganalyze-AbCdEf"`) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ResourceNotFoundException","Message":"Secrets Manager can't find the specified secret."}`))
+			return
+		}
+		w.Write([]byte(`{"Name":"pganalyze","SecretString":"{\"username\":\"pganalyze\",\"password\":\"rotated\",\"engine\":\"postgres\"}"}`))
+	}))
+	defer server.Close()
+
+	provider, _ := newTestProvider(t, config.ServerConfig{
+		DbSecretProvider:             "aws_secrets_manager",
+		AwsSecretsManagerSecretID:    "arn:aws:secretsmanager:eu-west-1:123456789012:secret:pganalyze-AbCdEf",
+		AwsEndpointSecretsManagerURL: server.URL,
+		AwsRegion:                    "us-east-1",
+		AwsAccessKeyID:               "AKIDEXAMPLE",
+		AwsSecretAccessKey:           "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	})
+
+	expectCredentials(t, provider, "pganalyze", "rotated")
+}
+
+func TestAwsSecretsManagerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type":"ResourceNotFoundException","Message":"Secrets Manager can't find the specified secret."}`))
+	}))
+	defer server.Close()
+
+	provider, _ := newTestProvider(t, config.ServerConfig{
+		DbSecretProvider:             "aws_secrets_manager",
+		AwsSecretsManagerSecretID:    "missing",
+		AwsEndpointSecretsManagerURL: server.URL,
+		AwsRegion:                    "us-east-1",
+		AwsAccessKeyID:               "AKIDEXAMPLE",
+		AwsSecretAccessKey:           "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	})
+
+	_, err := provider.Credentials(context.Background())
+	expected := "failed to retrieve database credentials from aws_secrets_manager secret provider: AWS Secrets Manager returned HTTP 400: ResourceNotFoundException Secrets Manager can't find the specified secret."
+	if err == nil || err.Error() != expected {
+		t.Errorf("want %q; got %v", expected, err)
+	}
+}
@@ -0,0 +1,205 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+)
+
+// Minimal client for the HashiCorp Vault HTTP API
+//
+// See https://developer.hashicorp.com/vault/api-docs
+type vaultClient struct {
+	addr       string
+	token      string
+	namespace  string
+	httpClient *http.Client
+}
+
+func newVaultClient(cfg config.ServerConfig) *vaultClient {
+	return &vaultClient{
+		addr:       strings.TrimSuffix(cfg.VaultAddr, "/"),
+		token:      cfg.VaultToken,
+		namespace:  cfg.VaultNamespace,
+		httpClient: newHTTPClient(cfg),
+	}
+}
+
+// Response envelope shared by Vault API endpoints
+type vaultResponse struct {
+	LeaseID       string          `json:"lease_id"`
+	LeaseDuration int             `json:"lease_duration"`
+	Renewable     bool            `json:"renewable"`
+	Data          json.RawMessage `json:"data"`
+	Errors        []string        `json:"errors"`
+}
+
+func (c *vaultClient) request(ctx context.Context, method string, path string, body any) (*vaultResponse, error) {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.addr+"/v1/"+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result vaultResponse
+	if len(respBody) > 0 {
+		err = json.Unmarshal(respBody, &result)
+		if err != nil && resp.StatusCode == http.StatusOK {
+			return nil, fmt.Errorf("failed to decode Vault response: %s", err)
+		}
+	}
+	// Some endpoints respond with 204 No Content (e.g. revoking a lease)
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		if len(result.Errors) > 0 {
+			return nil, fmt.Errorf("Vault returned HTTP %d for %s: %s", resp.StatusCode, path, strings.Join(result.Errors, "; "))
+		}
+		return nil, fmt.Errorf("Vault returned HTTP %d for %s", resp.StatusCode, path)
+	}
+
+	return &result, nil
+}
+
+// Static credentials from the Vault KV secrets engine (version 2)
+//
+// See https://developer.hashicorp.com/vault/api-docs/secret/kv/kv-v2#read-secret-version
+type vaultKVSource struct {
+	client          *vaultClient
+	mount           string
+	path            string
+	refreshInterval time.Duration
+}
+
+func (s *vaultKVSource) fetch(ctx context.Context, now time.Time) (Credentials, error) {
+	path := strings.Trim(s.mount, "/") + "/data/" + strings.Trim(s.path, "/")
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var data struct {
+		Data map[string]any `json:"data"`
+	}
+	err = json.Unmarshal(resp.Data, &data)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to decode Vault KV secret: %s", err)
+	}
+	creds, err := credentialsFromSecret(data.Data)
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds.refreshAt = now.Add(s.refreshInterval)
+	return creds, nil
+}
+
+// Dynamic credentials from the Vault database secrets engine, which are valid
+// for the duration of their lease
+//
+// See https://developer.hashicorp.com/vault/api-docs/secret/databases#generate-credentials
+type vaultDatabaseSource struct {
+	client *vaultClient
+	mount  string
+	role   string
+}
+
+func (s *vaultDatabaseSource) fetch(ctx context.Context, now time.Time) (Credentials, error) {
+	path := strings.Trim(s.mount, "/") + "/creds/" + s.role
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var data map[string]any
+	err = json.Unmarshal(resp.Data, &data)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to decode Vault database credentials: %s", err)
+	}
+	creds, err := credentialsFromSecret(data)
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds.leaseID = resp.LeaseID
+	creds.renewable = resp.Renewable
+	setLease(&creds, time.Duration(resp.LeaseDuration)*time.Second, now)
+	creds.issuedLeaseDuration = creds.leaseDuration
+	return creds, nil
+}
+
+// Extends the lease of dynamic credentials by their original lease duration
+//
+// See https://developer.hashicorp.com/vault/api-docs/system/leases#renew-lease
+func (s *vaultDatabaseSource) renew(ctx context.Context, creds Credentials, now time.Time) (Credentials, error) {
+	resp, err := s.client.request(ctx, http.MethodPut, "sys/leases/renew", map[string]any{
+		"lease_id":  creds.leaseID,
+		"increment": int(creds.issuedLeaseDuration.Seconds()),
+	})
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds.renewable = resp.Renewable
+	setLease(&creds, time.Duration(resp.LeaseDuration)*time.Second, now)
+	return creds, nil
+}
+
+// Revokes the lease of dynamic credentials that were replaced, so they don't
+// stay valid until their lease expires
+//
+// See https://developer.hashicorp.com/vault/api-docs/system/leases#revoke-lease
+func (s *vaultDatabaseSource) revoke(ctx context.Context, creds Credentials) error {
+	_, err := s.client.request(ctx, http.MethodPut, "sys/leases/revoke", map[string]any{
+		"lease_id": creds.leaseID,
+	})
+	return err
+}
+
+// Renew (or replace) credentials after two thirds of their lease have passed,
+// to leave enough time for retries before they expire
+func setLease(creds *Credentials, duration time.Duration, now time.Time) {
+	creds.leaseDuration = duration
+	creds.leaseExpiry = now.Add(duration)
+	creds.refreshAt = now.Add(duration * 2 / 3)
+}
+
+// Extracts the username and password from a secret's key/value data
+func credentialsFromSecret(data map[string]any) (Credentials, error) {
+	username, _ := data["username"].(string)
+	password, _ := data["password"].(string)
+	if username == "" || password == "" {
+		return Credentials{}, fmt.Errorf("secret does not contain \"username\" and \"password\" keys")
+	}
+	return Credentials{Username: username, Password: password}, nil
+}
@@ -7,6 +7,9 @@ import (
 //go:embed helpers/explain_analyze.sql
 var ExplainAnalyzeHelper string
 
+//go:embed helpers/maintenance_role.sql
+var MaintenanceRoleHelper string
+
 //go:embed helpers/get_stat_statements.sql
 var GetStatStatementsHelper string
 